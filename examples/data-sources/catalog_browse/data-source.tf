@@ -0,0 +1,20 @@
+# Find all tables below /XYZ whose name starts with ABC.
+data "sapdi_catalog_browse" "xyz" {
+  connection_id = "P40_XYZ"
+  path          = "/XYZ"
+  depth         = 2
+  filter        = "ABC*"
+}
+
+# Read the factsheet of each table found.
+data "sapdi_factsheet" "xyz" {
+  for_each = {
+    for object in data.sapdi_catalog_browse.xyz.objects : object.qualified_name => object
+    if object.type == "TABLE"
+  }
+
+  metadata = {
+    connection_id = "P40_XYZ"
+    uri           = each.key
+  }
+}
@@ -0,0 +1,5 @@
+# Get all ABAP connections tagged with "erp".
+data "sapdi_connections" "erp" {
+  type = "ABAP"
+  tag  = "erp"
+}
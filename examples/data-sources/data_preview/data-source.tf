@@ -0,0 +1,15 @@
+# Read a few rows of a dataset after a deployment.
+data "sapdi_data_preview" "abcd" {
+  connection_id = "P40_XYZ"
+  dataset_uri   = "/XYZ/012/ABCD"
+  columns       = ["MANDT", "ANZST"]
+  limit         = 5
+}
+
+# Fail the apply when the dataset is empty.
+check "abcd_has_rows" {
+  assert {
+    condition     = length(data.sapdi_data_preview.abcd.rows) > 0
+    error_message = "Dataset /XYZ/012/ABCD returned no rows."
+  }
+}
@@ -0,0 +1,17 @@
+# Find the tables of P40 dealing with materials.
+data "sapdi_dataset_search" "material" {
+  query         = "material"
+  connection_id = "P40_XYZ"
+  type          = "TABLE"
+  max_results   = 20
+}
+
+# Read the factsheet of each dataset found.
+data "sapdi_factsheet" "material" {
+  for_each = { for result in data.sapdi_dataset_search.material.results : result.uri => result }
+
+  metadata = {
+    connection_id = each.value.connection_id
+    uri           = each.value.uri
+  }
+}
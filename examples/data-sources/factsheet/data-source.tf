@@ -4,4 +4,9 @@ data "sapdi_factsheet" "test" {
     uri           = "/XYZ/012/ABCD"
     connection_id = "P40_XYZ"
   }
+
+  # Large factsheets may take a while to be computed.
+  timeouts {
+    read = "10m"
+  }
 }
@@ -0,0 +1,9 @@
+# Get all terms of the finance glossary.
+data "sapdi_glossary_terms" "finance" {
+  glossary_id = "GLOSSARY_0001"
+}
+
+# Look up term IDs by name.
+output "finance_term_ids" {
+  value = { for term in data.sapdi_glossary_terms.finance.terms : term.name => term.id }
+}
@@ -0,0 +1,14 @@
+# Get everything fed by the dataset.
+data "sapdi_lineage" "abcd" {
+  connection_id = "P40_XYZ"
+  dataset_uri   = "/XYZ/012/ABCD"
+  direction     = "downstream"
+}
+
+# List the datasets which would break if ABCD was removed.
+output "abcd_consumers" {
+  value = [
+    for node in data.sapdi_lineage.abcd.nodes : node.qualified_name
+    if node.type == "DATASET" && node.qualified_name != "/XYZ/012/ABCD"
+  ]
+}
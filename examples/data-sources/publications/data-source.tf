@@ -0,0 +1,12 @@
+# Get all publications of the P40 system.
+data "sapdi_publications" "p40" {
+  connection_id = "P40_XYZ"
+}
+
+# Fail the run when the latest publication did not succeed.
+check "publications_succeeded" {
+  assert {
+    condition     = alltrue([for p in data.sapdi_publications.p40.publications : p.last_status == "COMPLETED"])
+    error_message = "At least one publication of P40_XYZ did not complete."
+  }
+}
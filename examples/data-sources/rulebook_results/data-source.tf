@@ -0,0 +1,12 @@
+# Get the latest results of a rulebook.
+data "sapdi_rulebook_results" "master_data" {
+  rulebook_id = "RB_0001"
+}
+
+# Fail the apply when the data quality drops below 95 percent.
+check "master_data_quality" {
+  assert {
+    condition     = data.sapdi_rulebook_results.master_data.pass_rate >= 95
+    error_message = "Only ${data.sapdi_rulebook_results.master_data.pass_rate}% of the master data rows passed the quality rules."
+  }
+}
@@ -0,0 +1,11 @@
+# Get all tag hierarchies including their tags.
+data "sapdi_tag_hierarchies" "all" {}
+
+# Look up tag IDs by their path, e.g. "PII > Email".
+output "tag_ids" {
+  value = merge([
+    for hierarchy in data.sapdi_tag_hierarchies.all.hierarchies : {
+      for tag in hierarchy.tags : "${hierarchy.name}: ${tag.path}" => tag.id
+    }
+  ]...)
+}
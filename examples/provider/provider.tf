@@ -3,3 +3,13 @@ provider "sapdi" {
   password = "test123"
   host     = "http://localhost:12345"
 }
+
+# Authenticate with OAuth2 client credentials instead of basic auth.
+provider "sapdi" {
+  alias         = "oauth2"
+  host          = "https://di.example.com"
+  auth_method   = "oauth2_client_credentials"
+  token_url     = "https://example.authentication.eu10.hana.ondemand.com/oauth/token"
+  client_id     = "sb-di-automation"
+  client_secret = var.client_secret
+}
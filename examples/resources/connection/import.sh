@@ -0,0 +1,2 @@
+# Connections can be imported by specifying the connection ID.
+terraform import sapdi_connection.p40 P40_XYZ
@@ -0,0 +1,16 @@
+# Manage an ABAP connection.
+resource "sapdi_connection" "p40" {
+  id          = "P40_XYZ"
+  type        = "ABAP"
+  description = "ABAP system P40"
+  tags        = ["abap", "erp"]
+
+  content_data = {
+    protocol     = "RFC"
+    ashost       = "p40.example.com"
+    systemNumber = "00"
+    client       = "100"
+    user         = "DI_USER"
+    password     = var.p40_password
+  }
+}
@@ -0,0 +1,2 @@
+# Dataset tags can be imported by specifying the connection ID and the dataset URI, separated by a comma.
+terraform import sapdi_dataset_tags.customers P40_XYZ,/XYZ/012/ABCD
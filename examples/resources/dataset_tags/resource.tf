@@ -0,0 +1,10 @@
+# Classify a dataset as PII and tag its email column.
+resource "sapdi_dataset_tags" "customers" {
+  connection_id = "P40_XYZ"
+  dataset_uri   = "/XYZ/012/ABCD"
+  tag_ids       = [sapdi_tag.pii.id]
+
+  column_tag_ids = {
+    EMAIL = [sapdi_tag.email.id]
+  }
+}
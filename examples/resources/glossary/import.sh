@@ -0,0 +1,2 @@
+# Glossaries can be imported by specifying the glossary ID.
+terraform import sapdi_glossary.finance GLOSSARY_0001
@@ -0,0 +1,5 @@
+# Manage the glossary of the finance department.
+resource "sapdi_glossary" "finance" {
+  name        = "Finance"
+  description = "Business terms of the finance department"
+}
@@ -0,0 +1,2 @@
+# Glossary terms can be imported by specifying the term ID.
+terraform import sapdi_glossary_term.company_code TERM_0001
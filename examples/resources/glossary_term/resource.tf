@@ -0,0 +1,19 @@
+# Manage two related terms of the finance glossary.
+resource "sapdi_glossary_term" "company_code" {
+  glossary_id = sapdi_glossary.finance.id
+  name        = "Company Code"
+  description = "Smallest organizational unit with a complete self-contained set of accounts"
+  synonyms    = ["BUKRS", "CoCd"]
+
+  custom_attributes = {
+    owner  = "finance-stewards"
+    domain = "Accounting"
+  }
+}
+
+resource "sapdi_glossary_term" "chart_of_accounts" {
+  glossary_id      = sapdi_glossary.finance.id
+  name             = "Chart of Accounts"
+  description      = "List of all general ledger accounts"
+  related_term_ids = [sapdi_glossary_term.company_code.id]
+}
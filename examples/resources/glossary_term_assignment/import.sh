@@ -0,0 +1,3 @@
+# Assignments can be imported by specifying the term ID, connection ID,
+# dataset URI and optionally the column name, separated by commas.
+terraform import sapdi_glossary_term_assignment.abcd_mandt TERM_0001,P40_XYZ,/XYZ/012/ABCD,MANDT
@@ -0,0 +1,14 @@
+# Assign a term to the whole dataset.
+resource "sapdi_glossary_term_assignment" "abcd_material" {
+  term_id       = sapdi_glossary_term.material.id
+  connection_id = "P40_XYZ"
+  dataset_uri   = "/XYZ/012/ABCD"
+}
+
+# Assign a term to a single column.
+resource "sapdi_glossary_term_assignment" "abcd_mandt" {
+  term_id       = sapdi_glossary_term.client.id
+  connection_id = "P40_XYZ"
+  dataset_uri   = "/XYZ/012/ABCD"
+  column_name   = "MANDT"
+}
@@ -0,0 +1,2 @@
+# Graphs can be imported by specifying the graph name.
+terraform import sapdi_graph.ingest_orders com.mycompany.ingest.orders
@@ -0,0 +1,5 @@
+# Manage a graph exported from the Modeler of another tenant.
+resource "sapdi_graph" "ingest_orders" {
+  name    = "com.mycompany.ingest.orders"
+  content = file("${path.module}/graphs/com.mycompany.ingest.orders.json")
+}
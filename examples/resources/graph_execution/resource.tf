@@ -0,0 +1,28 @@
+# Keep the replication graph running, it is started again when it died.
+resource "sapdi_graph_execution" "orders" {
+  graph_name = sapdi_graph.orders.name
+
+  substitutions = {
+    SOURCE_PATH = "/orders"
+  }
+
+  # Restart the graph whenever it is changed.
+  triggers = {
+    content = sha1(sapdi_graph.orders.content)
+  }
+
+  timeouts {
+    create = "10m"
+    delete = "10m"
+  }
+}
+
+# Run a one-off load and wait for it to complete.
+resource "sapdi_graph_execution" "initial_load" {
+  graph_name    = "com.mycompany.ingest.initial_load"
+  desired_state = "completed"
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,13 @@
+# Profile the dataset again whenever schema_version is changed.
+resource "sapdi_profiling_task" "abcd" {
+  connection_id = "P40_XYZ"
+  dataset_uri   = "/XYZ/012/ABCD"
+
+  triggers = {
+    schema_version = "2"
+  }
+
+  timeouts {
+    create = "1h"
+  }
+}
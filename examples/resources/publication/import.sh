@@ -0,0 +1,2 @@
+# Publications can be imported by specifying the publication ID.
+terraform import sapdi_publication.xyz_012 PUB_0001
@@ -0,0 +1,15 @@
+# Publish all tables of a package into the catalog.
+resource "sapdi_publication" "xyz_012" {
+  name               = "P40 XYZ/012"
+  description        = "Tables of the XYZ/012 package"
+  connection_id      = "P40_XYZ"
+  path               = "/XYZ/012"
+  include_subfolders = true
+  with_lineage       = true
+  target_folder      = "/P40"
+
+  timeouts {
+    create = "1h"
+    update = "1h"
+  }
+}
@@ -0,0 +1,2 @@
+# Rules can be imported by specifying the rule ID.
+terraform import sapdi_rule.client_set RULE_0001
@@ -0,0 +1,32 @@
+# Check that the client of all active records is set.
+resource "sapdi_rule" "client_set" {
+  category_id = sapdi_rule_category.completeness.id
+  name        = "Client is set"
+  description = "Checks that the client of active records is set"
+
+  parameters = [
+    {
+      name        = "client"
+      type        = "STRING"
+      description = "Client of the record"
+    },
+    {
+      name = "status"
+      type = "STRING"
+    },
+  ]
+
+  filters = [
+    {
+      name       = "Active records"
+      expression = "$status = 'A'"
+    },
+  ]
+
+  conditions = [
+    {
+      name       = "Client not empty"
+      expression = "$client IS NOT NULL AND $client != ''"
+    },
+  ]
+}
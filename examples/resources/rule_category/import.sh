@@ -0,0 +1,2 @@
+# Rule categories can be imported by specifying the category ID.
+terraform import sapdi_rule_category.completeness RCAT_0001
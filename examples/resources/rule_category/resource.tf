@@ -0,0 +1,5 @@
+# Manage a category for completeness checks.
+resource "sapdi_rule_category" "completeness" {
+  name        = "Completeness"
+  description = "Checks for missing values"
+}
@@ -0,0 +1,2 @@
+# Rulebooks can be imported by specifying the rulebook ID.
+terraform import sapdi_rulebook.master_data RB_0001
@@ -0,0 +1,17 @@
+# Apply the rule to a master data table.
+resource "sapdi_rulebook" "master_data" {
+  name        = "Master Data Quality"
+  description = "Quality checks of the master data tables"
+
+  bindings = [
+    {
+      rule_id       = sapdi_rule.client_set.id
+      connection_id = "P40_XYZ"
+      dataset_uri   = "/XYZ/012/ABCD"
+      column_mapping = {
+        client = "MANDT"
+        status = "ANZST"
+      }
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+# Evaluate the rulebook on every change of its rules or bindings.
+resource "sapdi_rulebook_run" "master_data" {
+  rulebook_id = sapdi_rulebook.master_data.id
+
+  triggers = {
+    rulebook = jsonencode(sapdi_rulebook.master_data.bindings)
+  }
+
+  timeouts {
+    create = "1h"
+  }
+}
+
+output "master_data_pass_rate" {
+  value = sapdi_rulebook_run.master_data.pass_rate
+}
@@ -0,0 +1,2 @@
+# Tags can be imported by specifying the hierarchy ID and the tag ID, separated by a comma.
+terraform import sapdi_tag.email HIER_0001,TAG_0002
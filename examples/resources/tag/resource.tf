@@ -0,0 +1,13 @@
+# Manage a top-level tag and a nested tag below it.
+resource "sapdi_tag" "pii" {
+  hierarchy_id = sapdi_tag_hierarchy.classification.id
+  name         = "PII"
+  description  = "Personally identifiable information"
+}
+
+resource "sapdi_tag" "email" {
+  hierarchy_id = sapdi_tag_hierarchy.classification.id
+  parent_id    = sapdi_tag.pii.id
+  name         = "Email"
+  description  = "Email addresses"
+}
@@ -0,0 +1,2 @@
+# Tag hierarchies can be imported by specifying the hierarchy ID.
+terraform import sapdi_tag_hierarchy.classification HIER_0001
@@ -0,0 +1,5 @@
+# Manage a hierarchy to classify datasets by sensitivity.
+resource "sapdi_tag_hierarchy" "classification" {
+  name        = "Data Classification"
+  description = "Classification of datasets by sensitivity"
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	stdpath "path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &catalogBrowseDataSource{}
+	_ datasource.DataSourceWithConfigure = &catalogBrowseDataSource{}
+)
+
+// NewCatalogBrowseDataSource is a helper function to simplify the provider implementation.
+func NewCatalogBrowseDataSource() datasource.DataSource {
+	return &catalogBrowseDataSource{}
+}
+
+// catalogBrowseDataSource is the data source implementation.
+type catalogBrowseDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *catalogBrowseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Catalog Browse data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Catalog Browse data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *catalogBrowseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_browse"
+}
+
+// Schema defines the schema for the data source.
+func (d *catalogBrowseDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Browses the folders, datasets and files of a connection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "ID of the browsed connection, e.g. P40_XYZ.",
+				Required:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Folder to browse, e.g. /XYZ/012. Defaults to the root folder /.",
+				Optional:    true,
+			},
+			"depth": schema.Int64Attribute{
+				Description: "Number of folder levels to browse. Defaults to 1, which only returns the direct children of path.",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Only return objects whose name matches this glob pattern, e.g. AB*. Folders are browsed regardless of the filter.",
+				Optional:    true,
+			},
+			"objects": schema.ListNestedAttribute{
+				Description: "List of objects below path, each folder followed by its children.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the object.",
+							Computed:    true,
+						},
+						"qualified_name": schema.StringAttribute{
+							Description: "Qualified name of the object, which is the dataset URI for datasets, e.g. /XYZ/012/ABCD.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the object, e.g. FOLDER, TABLE or FILE.",
+							Computed:    true,
+						},
+						"has_children": schema.BoolAttribute{
+							Description: "Whether the object contains further objects.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// catalogBrowseDataSourceModel maps the data source schema data.
+type catalogBrowseDataSourceModel struct {
+	ID           types.String         `tfsdk:"id"`
+	ConnectionId types.String         `tfsdk:"connection_id"`
+	Path         types.String         `tfsdk:"path"`
+	Depth        types.Int64          `tfsdk:"depth"`
+	Filter       types.String         `tfsdk:"filter"`
+	Objects      []catalogObjectModel `tfsdk:"objects"`
+	Timeouts     timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// catalogObjectModel maps catalog object schema data.
+type catalogObjectModel struct {
+	Name          types.String `tfsdk:"name"`
+	QualifiedName types.String `tfsdk:"qualified_name"`
+	Type          types.String `tfsdk:"type"`
+	HasChildren   types.Bool   `tfsdk:"has_children"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *catalogBrowseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state catalogBrowseDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Catalog Browse data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	folder := "/"
+	if !state.Path.IsNull() {
+		folder = state.Path.ValueString()
+	}
+
+	depth := int64(1)
+	if !state.Depth.IsNull() {
+		depth = state.Depth.ValueInt64()
+	}
+	if depth < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("depth"),
+			"Invalid Browse Depth",
+			fmt.Sprintf("The depth must be at least 1, got: %d.", depth),
+		)
+	}
+
+	// Validate the pattern once, as path.Match only reports bad patterns on a mismatch.
+	if _, err := stdpath.Match(state.Filter.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filter"),
+			"Invalid Browse Filter",
+			fmt.Sprintf("The filter %q is not a valid glob pattern: %s", state.Filter.ValueString(), err),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state.Objects = []catalogObjectModel{}
+	err := d.browse(ctx, &state, folder, depth)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Browse SAP DI catalog", err)
+		return
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// browse appends the children of folder matching the filter to the model,
+// descending into subfolders until depth levels were browsed.
+func (d *catalogBrowseDataSource) browse(ctx context.Context, state *catalogBrowseDataSourceModel, folder string, depth int64) error {
+	objects, err := d.client.BrowseCatalog(ctx, state.ConnectionId.ValueString(), folder)
+	if err != nil {
+		return fmt.Errorf("could not browse %s: %w", folder, err)
+	}
+
+	for _, object := range objects {
+		matched := true
+		if !state.Filter.IsNull() {
+			matched, _ = stdpath.Match(state.Filter.ValueString(), object.Name)
+		}
+
+		if matched {
+			state.Objects = append(state.Objects, catalogObjectModel{
+				Name:          types.StringValue(object.Name),
+				QualifiedName: types.StringValue(object.QualifiedName),
+				Type:          types.StringValue(object.Type),
+				HasChildren:   types.BoolValue(object.HasChildren),
+			})
+		}
+
+		if object.HasChildren && depth > 1 {
+			err = d.browse(ctx, state, object.QualifiedName, depth-1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
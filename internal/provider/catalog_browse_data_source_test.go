@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCatalogBrowseDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_catalog_browse" "test" {
+					connection_id = "P40_XYZ"
+					path          = "/XYZ/012"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.#", "3"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.0.name", "ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.0.qualified_name", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.0.type", "TABLE"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.0.has_children", "false"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.2.type", "FILE"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "id", "placeholder"),
+				),
+			},
+			// Browse recursively from the root folder
+			{
+				Config: providerConfig + `data "sapdi_catalog_browse" "test" {
+					connection_id = "P40_XYZ"
+					depth         = 3
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.#", "6"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.0.qualified_name", "/XYZ"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.1.qualified_name", "/XYZ/012"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.2.qualified_name", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.5.qualified_name", "/XYZ/013"),
+				),
+			},
+			// Filter by name
+			{
+				Config: providerConfig + `data "sapdi_catalog_browse" "test" {
+					connection_id = "P40_XYZ"
+					depth         = 3
+					filter        = "ABC*"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.0.name", "ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_catalog_browse.test", "objects.1.name", "ABCE"),
+				),
+			},
+			// Invalid depth
+			{
+				Config: providerConfig + `data "sapdi_catalog_browse" "test" {
+					connection_id = "P40_XYZ"
+					depth         = 0
+				}`,
+				ExpectError: regexp.MustCompile("The depth must be at least 1"),
+			},
+		},
+	})
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// connectionImportedKey marks a connection as freshly imported in the private state.
+const connectionImportedKey = "imported"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &connectionResource{}
+	_ resource.ResourceWithConfigure   = &connectionResource{}
+	_ resource.ResourceWithImportState = &connectionResource{}
+)
+
+// NewConnectionResource is a helper function to simplify the provider implementation.
+func NewConnectionResource() resource.Resource {
+	return &connectionResource{}
+}
+
+// connectionResource is the resource implementation.
+type connectionResource struct {
+	client *sap_di.Client
+}
+
+// connectionResourceModel maps the resource schema data.
+type connectionResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Set    `tfsdk:"tags"`
+	ContentData types.Map    `tfsdk:"content_data"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *connectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Connection resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Connection resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *connectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *connectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a connection in the SAP DI Connection Management.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the connection, e.g. P40_XYZ.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the connection, e.g. ABAP, HANA_DB or S3.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the connection.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags of the connection.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"content_data": schema.MapAttribute{
+				Description: "Type-specific connection properties, e.g. host, port, user and password. " +
+					"Only the keys set here are checked for drift, as SAP DI does not return secrets.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *connectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan connectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, diags := plan.toConnection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI connection", map[string]any{"id": connection.Id})
+
+	created, err := r.client.CreateConnection(ctx, *connection)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI connection", fmt.Errorf("could not create connection: %w", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromConnection(ctx, created, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *connectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state connectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.client.GetConnection(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI connection not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI connection", fmt.Errorf("could not read SAP DI connection ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, connectionImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromConnection(ctx, connection, imported != nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if imported != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, connectionImportedKey, nil)...)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *connectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan connectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, diags := plan.toConnection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateConnection(ctx, *connection)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI connection", fmt.Errorf("could not update connection: %w", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromConnection(ctx, updated, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *connectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state connectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteConnection(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI connection", fmt.Errorf("could not delete connection: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing connection by its ID.
+func (r *connectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Let the following Read take over all content data returned by SAP DI.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, connectionImportedKey, []byte("true"))...)
+}
+
+// toConnection converts the resource model into a SAP DI connection.
+func (m connectionResourceModel) toConnection(ctx context.Context) (*sap_di.Connection, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	connection := &sap_di.Connection{
+		Id:          m.ID.ValueString(),
+		Type:        m.Type.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        []string{},
+	}
+
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		diags.Append(m.Tags.ElementsAs(ctx, &connection.Tags, false)...)
+	}
+
+	if !m.ContentData.IsNull() && !m.ContentData.IsUnknown() {
+		contentData := map[string]string{}
+		diags.Append(m.ContentData.ElementsAs(ctx, &contentData, false)...)
+
+		connection.ContentData = map[string]any{}
+		for key, value := range contentData {
+			connection.ContentData[key] = value
+		}
+	}
+
+	return connection, diags
+}
+
+// fromConnection maps a SAP DI connection onto the resource model. Only
+// content_data keys already tracked in the model are refreshed, since SAP DI
+// omits secrets and adds type-specific defaults. After an import every
+// returned key is taken over instead.
+func (m *connectionResourceModel) fromConnection(ctx context.Context, connection *sap_di.Connection, imported bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(connection.Id)
+	m.Type = types.StringValue(connection.Type)
+	m.Description = types.StringValue(connection.Description)
+
+	tags := connection.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	m.Tags, diags = types.SetValueFrom(ctx, types.StringType, tags)
+	if diags.HasError() {
+		return diags
+	}
+
+	contentData := map[string]string{}
+	switch {
+	case imported:
+		if len(connection.ContentData) == 0 {
+			return diags
+		}
+		for key, value := range connection.ContentData {
+			contentData[key] = valueString(value)
+		}
+	case !m.ContentData.IsNull() && !m.ContentData.IsUnknown():
+		diags.Append(m.ContentData.ElementsAs(ctx, &contentData, false)...)
+		for key := range contentData {
+			if value, ok := connection.ContentData[key]; ok {
+				contentData[key] = valueString(value)
+			}
+		}
+	default:
+		return diags
+	}
+
+	contentDataValue, d := types.MapValueFrom(ctx, types.StringType, contentData)
+	diags.Append(d...)
+	m.ContentData = contentDataValue
+
+	return diags
+}
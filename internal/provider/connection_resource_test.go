@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_connection" "test" {
+					id          = "P40_XYZ"
+					type        = "ABAP"
+					description = "ABAP system P40"
+					tags        = ["abap", "erp"]
+					content_data = {
+						ashost   = "p40.example.com"
+						client   = "100"
+						user     = "DI_USER"
+						password = "secret"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_connection.test", "id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("sapdi_connection.test", "type", "ABAP"),
+					resource.TestCheckResourceAttr("sapdi_connection.test", "description", "ABAP system P40"),
+					resource.TestCheckResourceAttr("sapdi_connection.test", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sapdi_connection.test", "tags.*", "abap"),
+					resource.TestCheckTypeSetElemAttr("sapdi_connection.test", "tags.*", "erp"),
+
+					// Verify only configured content data is tracked, secrets are kept
+					resource.TestCheckResourceAttr("sapdi_connection.test", "content_data.%", "4"),
+					resource.TestCheckResourceAttr("sapdi_connection.test", "content_data.ashost", "p40.example.com"),
+					resource.TestCheckResourceAttr("sapdi_connection.test", "content_data.password", "secret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_connection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// SAP DI does not return secrets but type-specific defaults.
+				ImportStateVerifyIgnore: []string{"content_data"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_connection" "test" {
+					id   = "P40_XYZ"
+					type = "ABAP"
+					content_data = {
+						ashost   = "p40.example.com"
+						client   = "200"
+						user     = "DI_USER"
+						password = "secret"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_connection.test", "content_data.client", "200"),
+
+					// Verify removed attributes are cleared
+					resource.TestCheckResourceAttr("sapdi_connection.test", "description", ""),
+					resource.TestCheckResourceAttr("sapdi_connection.test", "tags.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
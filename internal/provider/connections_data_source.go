@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &connectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectionsDataSource{}
+)
+
+// NewConnectionsDataSource is a helper function to simplify the provider implementation.
+func NewConnectionsDataSource() datasource.DataSource {
+	return &connectionsDataSource{}
+}
+
+// connectionsDataSource is the data source implementation.
+type connectionsDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *connectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Connections data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Connections data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *connectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connections"
+}
+
+// Schema defines the schema for the data source.
+func (d *connectionsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all connections, optionally filtered by type and tag.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return connections of this type, e.g. ABAP, HANA_DB or S3.",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Only return connections carrying this tag.",
+				Optional:    true,
+			},
+			"connections": schema.ListNestedAttribute{
+				Description: "List of connections.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the connection.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the connection.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the connection.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags of the connection.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// connectionsDataSourceModel maps the data source schema data.
+type connectionsDataSourceModel struct {
+	ID          types.String       `tfsdk:"id"`
+	Type        types.String       `tfsdk:"type"`
+	Tag         types.String       `tfsdk:"tag"`
+	Connections []connectionsModel `tfsdk:"connections"`
+	Timeouts    timeouts.Value     `tfsdk:"timeouts"`
+}
+
+// connectionsModel maps connection schema data.
+type connectionsModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Type        types.String   `tfsdk:"type"`
+	Description types.String   `tfsdk:"description"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *connectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state connectionsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Connections data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	connections, err := d.client.ListConnections(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI connections", err)
+		return
+	}
+
+	// Map response body to model
+	state.Connections = []connectionsModel{}
+	for _, connection := range connections {
+		if !state.Type.IsNull() && connection.Type != state.Type.ValueString() {
+			continue
+		}
+
+		if !state.Tag.IsNull() && !containsString(connection.Tags, state.Tag.ValueString()) {
+			continue
+		}
+
+		conn := connectionsModel{
+			ID:          types.StringValue(connection.Id),
+			Type:        types.StringValue(connection.Type),
+			Description: types.StringValue(connection.Description),
+			Tags:        []types.String{},
+		}
+
+		for _, tag := range connection.Tags {
+			conn.Tags = append(conn.Tags, types.StringValue(tag))
+		}
+
+		state.Connections = append(state.Connections, conn)
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// containsString reports whether value is part of values.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConnectionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_connections" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of connections returned
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.#", "3"),
+					// Verify the first connection to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.0.id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.0.type", "ABAP"),
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.0.description", "ABAP system P40"),
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.0.tags.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.0.tags.0", "abap"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "id", "placeholder"),
+				),
+			},
+			// Filter by type
+			{
+				Config: providerConfig + `data "sapdi_connections" "test" {
+					type = "HANA_DB"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.0.id", "HANA_PROD"),
+				),
+			},
+			// Filter by tag
+			{
+				Config: providerConfig + `data "sapdi_connections" "test" {
+					tag = "erp"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.0.id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("data.sapdi_connections.test", "connections.1.id", "S3_LANDING"),
+				),
+			},
+		},
+	})
+}
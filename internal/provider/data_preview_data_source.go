@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// defaultPreviewLimit is the number of rows returned without limit.
+const defaultPreviewLimit = 10
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &dataPreviewDataSource{}
+)
+
+// NewDataPreviewDataSource is a helper function to simplify the provider implementation.
+func NewDataPreviewDataSource() datasource.DataSource {
+	return &dataPreviewDataSource{}
+}
+
+// dataPreviewDataSource is the data source implementation.
+type dataPreviewDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Data Preview data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Data Preview data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *dataPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_preview"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataPreviewDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches sample rows of a dataset through the Metadata Explorer preview.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Connection ID of the dataset, e.g. P40_XYZ.",
+				Required:    true,
+			},
+			"dataset_uri": schema.StringAttribute{
+				Description: "URI of the dataset, e.g. /XYZ/012/ABCD.",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of returned rows, at most %d. Defaults to %d.", sap_di.MaxPreviewRows, defaultPreviewLimit),
+				Optional:    true,
+			},
+			"columns": schema.ListAttribute{
+				Description: "Only return these columns. All columns are returned if not set.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"column_names": schema.ListAttribute{
+				Description: "Names of the returned columns in dataset order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"rows": schema.ListAttribute{
+				Description: "Sample rows as maps of column name to value. Non-string values are rendered as JSON, null values are kept null.",
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// dataPreviewDataSourceModel maps the data source schema data.
+type dataPreviewDataSourceModel struct {
+	ID           types.String              `tfsdk:"id"`
+	ConnectionId types.String              `tfsdk:"connection_id"`
+	DatasetUri   types.String              `tfsdk:"dataset_uri"`
+	Limit        types.Int64               `tfsdk:"limit"`
+	Columns      []types.String            `tfsdk:"columns"`
+	ColumnNames  []types.String            `tfsdk:"column_names"`
+	Rows         []map[string]types.String `tfsdk:"rows"`
+	Timeouts     timeouts.Value            `tfsdk:"timeouts"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dataPreviewDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Data Preview data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	limit := defaultPreviewLimit
+	if !state.Limit.IsNull() {
+		if state.Limit.ValueInt64() < 1 || state.Limit.ValueInt64() > sap_di.MaxPreviewRows {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limit"),
+				"Invalid Preview Limit",
+				fmt.Sprintf("The preview limit must be between 1 and %d, got: %d.", sap_di.MaxPreviewRows, state.Limit.ValueInt64()),
+			)
+			return
+		}
+		limit = int(state.Limit.ValueInt64())
+	}
+
+	columns := []string{}
+	for _, column := range state.Columns {
+		columns = append(columns, column.ValueString())
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	preview, err := d.client.GetDataPreview(
+		ctx,
+		state.ConnectionId.ValueString(),
+		state.DatasetUri.ValueString(),
+		columns,
+		limit,
+	)
+	if sap_di.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dataset_uri"),
+			"SAP DI Dataset Not Found",
+			fmt.Sprintf(
+				"Dataset %s not found in connection %s. Ensure the dataset exists and the connection supports data preview.\n\n"+
+					"SAP DI Client Error: %s",
+				state.DatasetUri.ValueString(),
+				state.ConnectionId.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI data preview", err)
+		return
+	}
+
+	returned := map[string]bool{}
+	for _, column := range preview.Columns {
+		returned[column.Name] = true
+	}
+	for _, column := range columns {
+		if !returned[column] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("columns"),
+				"SAP DI Column Not Found",
+				fmt.Sprintf("Column %s not found in dataset %s.", column, state.DatasetUri.ValueString()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.ColumnNames = []types.String{}
+	for _, column := range preview.Columns {
+		state.ColumnNames = append(state.ColumnNames, types.StringValue(column.Name))
+	}
+
+	state.Rows = []map[string]types.String{}
+	for _, values := range preview.Rows {
+		row := map[string]types.String{}
+		for i, column := range preview.Columns {
+			row[column.Name] = types.StringNull()
+			if i < len(values) && values[i] != nil {
+				row[column.Name] = types.StringValue(valueString(values[i]))
+			}
+		}
+
+		state.Rows = append(state.Rows, row)
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataPreviewDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_data_preview" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					limit         = 2
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_data_preview.test", "column_names.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_data_preview.test", "column_names.0", "MANDT"),
+
+					// Verify the limit is enforced by the client
+					resource.TestCheckResourceAttr("data.sapdi_data_preview.test", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_data_preview.test", "rows.0.MANDT", "100"),
+					// Verify numbers are rendered as strings
+					resource.TestCheckResourceAttr("data.sapdi_data_preview.test", "rows.0.ANZST", "10"),
+					// Verify null values are kept null
+					resource.TestCheckNoResourceAttr("data.sapdi_data_preview.test", "rows.1.ANZST"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_data_preview.test", "id", "placeholder"),
+				),
+			},
+			// Unknown column testing
+			{
+				Config: providerConfig + `data "sapdi_data_preview" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					columns       = ["MANDT", "MISSING"]
+				}`,
+				ExpectError: regexp.MustCompile("Column MISSING not found in dataset /XYZ/012/ABCD"),
+			},
+			// Invalid limit testing
+			{
+				Config: providerConfig + `data "sapdi_data_preview" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					limit         = 5000
+				}`,
+				ExpectError: regexp.MustCompile("The preview limit must be between 1 and 1000"),
+			},
+		},
+	})
+}
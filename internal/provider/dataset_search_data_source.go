@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// defaultSearchMaxResults is the number of search results returned without max_results.
+const defaultSearchMaxResults = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &datasetSearchDataSource{}
+	_ datasource.DataSourceWithConfigure = &datasetSearchDataSource{}
+)
+
+// NewDatasetSearchDataSource is a helper function to simplify the provider implementation.
+func NewDatasetSearchDataSource() datasource.DataSource {
+	return &datasetSearchDataSource{}
+}
+
+// datasetSearchDataSource is the data source implementation.
+type datasetSearchDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *datasetSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Dataset Search data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Dataset Search data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *datasetSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_search"
+}
+
+// Schema defines the schema for the data source.
+func (d *datasetSearchDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the published datasets of the Metadata Explorer catalog by full text.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Full-text search string, e.g. a business term.",
+				Required:    true,
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Only return datasets of this connection, e.g. P40_XYZ.",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Only return datasets carrying this tag.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return objects of this type, e.g. TABLE or VIEW.",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of returned results. Defaults to %d.", defaultSearchMaxResults),
+				Optional:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "List of matching datasets, best match first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the dataset.",
+							Computed:    true,
+						},
+						"uri": schema.StringAttribute{
+							Description: "URI of the dataset, e.g. /XYZ/012/ABCD.",
+							Computed:    true,
+						},
+						"connection_id": schema.StringAttribute{
+							Description: "Connection ID of the dataset.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the dataset.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the dataset.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// datasetSearchDataSourceModel maps the data source schema data.
+type datasetSearchDataSourceModel struct {
+	ID           types.String         `tfsdk:"id"`
+	Query        types.String         `tfsdk:"query"`
+	ConnectionId types.String         `tfsdk:"connection_id"`
+	Tag          types.String         `tfsdk:"tag"`
+	Type         types.String         `tfsdk:"type"`
+	MaxResults   types.Int64          `tfsdk:"max_results"`
+	Results      []searchResultsModel `tfsdk:"results"`
+	Timeouts     timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// searchResultsModel maps search result schema data.
+type searchResultsModel struct {
+	Name         types.String `tfsdk:"name"`
+	Uri          types.String `tfsdk:"uri"`
+	ConnectionId types.String `tfsdk:"connection_id"`
+	Type         types.String `tfsdk:"type"`
+	Description  types.String `tfsdk:"description"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *datasetSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state datasetSearchDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Dataset Search data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	search := sap_di.SearchStruct{
+		Query:        state.Query.ValueString(),
+		ConnectionId: state.ConnectionId.ValueString(),
+		Tag:          state.Tag.ValueString(),
+		Type:         state.Type.ValueString(),
+		MaxResults:   defaultSearchMaxResults,
+	}
+
+	if !state.MaxResults.IsNull() {
+		if state.MaxResults.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_results"),
+				"Invalid Maximum Search Results",
+				fmt.Sprintf("The maximum number of search results must be at least 1, got: %d.", state.MaxResults.ValueInt64()),
+			)
+			return
+		}
+		search.MaxResults = int(state.MaxResults.ValueInt64())
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	results, err := d.client.SearchDatasets(ctx, search)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Search SAP DI catalog", err)
+		return
+	}
+
+	// Map response body to model
+	state.Results = []searchResultsModel{}
+	for _, result := range results {
+		state.Results = append(state.Results, searchResultsModel{
+			Name:         types.StringValue(result.Name),
+			Uri:          types.StringValue(result.QualifiedName),
+			ConnectionId: types.StringValue(result.ConnectionId),
+			Type:         types.StringValue(result.Type),
+			Description:  types.StringValue(result.Description),
+		})
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasetSearchDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_dataset_search" "test" {
+					query         = "characteristic"
+					connection_id = "P40_XYZ"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.0.name", "ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.0.uri", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.0.connection_id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.0.type", "TABLE"),
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.0.description", "Characteristic"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "id", "placeholder"),
+				),
+			},
+			// Limit the results
+			{
+				Config: providerConfig + `data "sapdi_dataset_search" "test" {
+					query       = "characteristic"
+					max_results = 1
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_dataset_search.test", "results.0.uri", "/XYZ/012/ABCD"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &datasetTagsResource{}
+	_ resource.ResourceWithConfigure   = &datasetTagsResource{}
+	_ resource.ResourceWithImportState = &datasetTagsResource{}
+)
+
+// NewDatasetTagsResource is a helper function to simplify the provider implementation.
+func NewDatasetTagsResource() resource.Resource {
+	return &datasetTagsResource{}
+}
+
+// datasetTagsResource is the resource implementation.
+type datasetTagsResource struct {
+	client *sap_di.Client
+}
+
+// datasetTagsResourceModel maps the resource schema data.
+type datasetTagsResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ConnectionId types.String `tfsdk:"connection_id"`
+	DatasetUri   types.String `tfsdk:"dataset_uri"`
+	TagIds       types.Set    `tfsdk:"tag_ids"`
+	ColumnTagIds types.Map    `tfsdk:"column_tag_ids"`
+}
+
+// columnTagIdsType is the element type of column_tag_ids.
+var columnTagIdsType = types.SetType{ElemType: types.StringType}
+
+// Configure adds the provider configured client to the resource.
+func (r *datasetTagsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Dataset Tags resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Dataset Tags resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *datasetTagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_tags"
+}
+
+// Schema defines the schema for the resource.
+func (r *datasetTagsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages all tags of a dataset and its columns. Tags assigned outside of Terraform are removed, " +
+			"destroying the resource removes every tag of the dataset.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Connection ID and dataset URI, separated by a comma.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Connection ID of the dataset, e.g. P40_XYZ.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset_uri": schema.StringAttribute{
+				Description: "URI of the dataset, e.g. /XYZ/012/ABCD.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag_ids": schema.SetAttribute{
+				Description: "IDs of the tags assigned to the dataset.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"column_tag_ids": schema.MapAttribute{
+				Description: "IDs of the tags assigned to columns of the dataset, by column name. " +
+					"Columns without tags are omitted, so every column needs at least one tag.",
+				ElementType: columnTagIdsType,
+				Optional:    true,
+				Computed:    true,
+				Default:     mapdefault.StaticValue(types.MapValueMust(columnTagIdsType, map[string]attr.Value{})),
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(setvalidator.SizeAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Create assigns the configured tags and sets the initial Terraform state.
+func (r *datasetTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan datasetTagsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Assigning SAP DI dataset tags", map[string]any{
+		"connection_id": plan.ConnectionId.ValueString(),
+		"dataset_uri":   plan.DatasetUri.ValueString(),
+	})
+
+	r.setTags(ctx, plan, &resp.State, &resp.Diagnostics, "Error Creating SAP DI dataset tags")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *datasetTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state datasetTagsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := r.client.GetDatasetTags(ctx, state.ConnectionId.ValueString(), state.DatasetUri.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI dataset not found, removing tags from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI dataset tags", fmt.Errorf("could not read tags of dataset %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromDatasetTags(ctx, tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update replaces the assigned tags and sets the updated Terraform state on success.
+func (r *datasetTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan datasetTagsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setTags(ctx, plan, &resp.State, &resp.Diagnostics, "Error Updating SAP DI dataset tags")
+}
+
+// Delete removes all tags of the dataset and its columns.
+func (r *datasetTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state datasetTagsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SetDatasetTags(ctx, state.ConnectionId.ValueString(), state.DatasetUri.ValueString(), sap_di.DatasetTags{
+		Tags:    []string{},
+		Columns: map[string][]string{},
+	})
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI dataset tags", fmt.Errorf("could not remove tags of dataset %s: %w", state.ID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports the tags of a dataset by its connection ID and dataset URI, separated by a comma.
+func (r *datasetTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: connection_id,dataset_uri. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_uri"), parts[1])...)
+}
+
+// setTags replaces the tags of the dataset with the planned ones and saves the result.
+func (r *datasetTagsResource) setTags(ctx context.Context, plan datasetTagsResourceModel, state *tfsdk.State, diags *diag.Diagnostics, summary string) {
+	tags, d := plan.toDatasetTags(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	updated, err := r.client.SetDatasetTags(ctx, plan.ConnectionId.ValueString(), plan.DatasetUri.ValueString(), tags)
+	if err != nil {
+		addClientError(diags, summary, fmt.Errorf("could not set tags of dataset %s in connection %s: %w", plan.DatasetUri.ValueString(), plan.ConnectionId.ValueString(), err))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ConnectionId.ValueString() + "," + plan.DatasetUri.ValueString())
+	diags.Append(plan.fromDatasetTags(ctx, updated)...)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// toDatasetTags converts the resource model into SAP DI dataset tags.
+func (m datasetTagsResourceModel) toDatasetTags(ctx context.Context) (sap_di.DatasetTags, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags := sap_di.DatasetTags{
+		Tags:    []string{},
+		Columns: map[string][]string{},
+	}
+
+	if !m.TagIds.IsNull() && !m.TagIds.IsUnknown() {
+		diags.Append(m.TagIds.ElementsAs(ctx, &tags.Tags, false)...)
+	}
+
+	if !m.ColumnTagIds.IsNull() && !m.ColumnTagIds.IsUnknown() {
+		diags.Append(m.ColumnTagIds.ElementsAs(ctx, &tags.Columns, false)...)
+	}
+
+	return tags, diags
+}
+
+// fromDatasetTags maps SAP DI dataset tags onto the resource model.
+func (m *datasetTagsResourceModel) fromDatasetTags(ctx context.Context, tags *sap_di.DatasetTags) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tagIds := tags.Tags
+	if tagIds == nil {
+		tagIds = []string{}
+	}
+	m.TagIds, diags = types.SetValueFrom(ctx, types.StringType, tagIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	columns := map[string][]string{}
+	for column, columnTagIds := range tags.Columns {
+		if len(columnTagIds) > 0 {
+			columns[column] = columnTagIds
+		}
+	}
+	m.ColumnTagIds, diags = types.MapValueFrom(ctx, columnTagIdsType, columns)
+
+	return diags
+}
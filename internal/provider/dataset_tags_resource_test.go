@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasetTagsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Column without tags testing
+			{
+				Config: providerConfig + `resource "sapdi_dataset_tags" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					column_tag_ids = {
+						MANDT = []
+					}
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_dataset_tags" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					tag_ids       = ["TAG_0001"]
+					column_tag_ids = {
+						MANDT = ["TAG_0002"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_dataset_tags.test", "id", "P40_XYZ,/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("sapdi_dataset_tags.test", "tag_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sapdi_dataset_tags.test", "tag_ids.*", "TAG_0001"),
+					resource.TestCheckResourceAttr("sapdi_dataset_tags.test", "column_tag_ids.%", "1"),
+					resource.TestCheckTypeSetElemAttr("sapdi_dataset_tags.test", "column_tag_ids.MANDT.*", "TAG_0002"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_dataset_tags.test",
+				ImportState:       true,
+				ImportStateId:     "P40_XYZ,/XYZ/012/ABCD",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
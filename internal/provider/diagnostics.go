@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// addClientError adds an error diagnostic for a failed SAP DI client call.
+// Rejected credentials are reported separately, as they have to be fixed in
+// the provider configuration rather than in the data source or resource.
+func addClientError(diags *diag.Diagnostics, summary string, err error) {
+	if sap_di.IsUnauthorized(err) {
+		diags.AddError(
+			"SAP DI Credentials Rejected",
+			"SAP DI rejected the configured credentials or denied access. "+
+				"Check the authentication settings of the provider and the permissions of the user.\n\n"+
+				"SAP DI Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	diags.AddError(summary, err.Error())
+}
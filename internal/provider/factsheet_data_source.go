@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -57,7 +59,7 @@ func (d *factsheetDataSource) Metadata(_ context.Context, req datasource.Metadat
 }
 
 // Schema defines the schema for the data source.
-func (d *factsheetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+func (d *factsheetDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	descriptionsObj := schema.ListNestedAttribute{
 		Description: "Descriptions of the factsheet.",
 		Computed:    true,
@@ -76,6 +78,63 @@ func (d *factsheetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 		},
 	}
 
+	propertiesObj := schema.ListNestedAttribute{
+		Description: "Additional properties.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"value": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	termsObj := schema.ListNestedAttribute{
+		Description: "Glossary terms assigned.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "ID of the term.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Name of the term.",
+					Computed:    true,
+				},
+				"glossary_id": schema.StringAttribute{
+					Description: "ID of the glossary containing the term.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	tagsObj := schema.ListNestedAttribute{
+		Description: "Tags assigned.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "ID of the tag.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Name of the tag.",
+					Computed:    true,
+				},
+				"hierarchy_id": schema.StringAttribute{
+					Description: "ID of the tag hierarchy containing the tag.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Fetches a factsheet.",
 		Attributes: map[string]schema.Attribute{
@@ -96,11 +155,80 @@ func (d *factsheetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 						Description: "URI for the factsheet.",
 						Required:    true,
 					},
+					"type": schema.StringAttribute{
+						Description: "Type of the dataset, e.g. TABLE.",
+						Computed:    true,
+					},
 					"connection_id": schema.StringAttribute{
 						Description: "Connection ID for the factsheet.",
 						Required:    true,
 					},
-					"descriptions": descriptionsObj,
+					"connection_type": schema.StringAttribute{
+						Description: "Type of the connection, e.g. ABAP.",
+						Computed:    true,
+					},
+					"col_count": schema.Int64Attribute{
+						Description: "Number of columns.",
+						Computed:    true,
+					},
+					"row_count": schema.Int64Attribute{
+						Description: "Number of rows.",
+						Computed:    true,
+					},
+					"sample_row_count": schema.Int64Attribute{
+						Description: "Number of rows the factsheet was sampled from.",
+						Computed:    true,
+					},
+					"sampled": schema.BoolAttribute{
+						Description: "Whether the factsheet was computed from a sample.",
+						Computed:    true,
+					},
+					"unique_keys": schema.ListNestedAttribute{
+						Description: "Unique keys of the dataset, e.g. the primary key.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"attribute_references": schema.ListAttribute{
+									Description: "Names of the columns forming the key.",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+							},
+						},
+					},
+					"properties":     propertiesObj,
+					"descriptions":   descriptionsObj,
+					"glossary_terms": termsObj,
+					"tags":           tagsObj,
+				},
+			},
+
+			"version": schema.StringAttribute{
+				Description: "Version of the factsheet format.",
+				Computed:    true,
+			},
+
+			"include_profile": schema.BoolAttribute{
+				Description: "Whether to fetch the statistics of the last profiling run into profile and columns.profile.",
+				Optional:    true,
+			},
+
+			"profile": schema.SingleNestedAttribute{
+				Description: "Dataset statistics of the last profiling run. Only set if include_profile is enabled and the dataset was profiled.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"row_count": schema.Int64Attribute{
+						Description: "Number of rows at profiling time.",
+						Computed:    true,
+					},
+					"sample_row_count": schema.Int64Attribute{
+						Description: "Number of rows the statistics were computed from.",
+						Computed:    true,
+					},
+					"timestamp": schema.StringAttribute{
+						Description: "Time of the profiling run.",
+						Computed:    true,
+					},
 				},
 			},
 
@@ -117,33 +245,167 @@ func (d *factsheetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 							Description: "Type of the column.",
 							Computed:    true,
 						},
-						"descriptions": descriptionsObj,
+						"template_type": schema.StringAttribute{
+							Description: "Template type of the column, e.g. string or int16.",
+							Computed:    true,
+						},
+						"length": schema.Int64Attribute{
+							Description: "Length of the column, if applicable to its type.",
+							Computed:    true,
+						},
+						"precision": schema.Int64Attribute{
+							Description: "Precision of the column, if applicable to its type.",
+							Computed:    true,
+						},
+						"scale": schema.Int64Attribute{
+							Description: "Scale of the column, if applicable to its type.",
+							Computed:    true,
+						},
+						"unique_groups": schema.StringAttribute{
+							Description: "Unique key groups the column belongs to.",
+							Computed:    true,
+						},
+						"properties":     propertiesObj,
+						"descriptions":   descriptionsObj,
+						"glossary_terms": termsObj,
+						"tags":           tagsObj,
+						"profile": schema.SingleNestedAttribute{
+							Description: "Column statistics of the last profiling run. Only set if include_profile is enabled and the dataset was profiled.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"null_count": schema.Int64Attribute{
+									Description: "Number of null values.",
+									Computed:    true,
+								},
+								"distinct_count": schema.Int64Attribute{
+									Description: "Number of distinct values.",
+									Computed:    true,
+								},
+								"min": schema.StringAttribute{
+									Description: "Minimum value.",
+									Computed:    true,
+								},
+								"max": schema.StringAttribute{
+									Description: "Maximum value.",
+									Computed:    true,
+								},
+								"top_values": schema.ListNestedAttribute{
+									Description: "Most frequent values.",
+									Computed:    true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"value": schema.StringAttribute{
+												Description: "Value.",
+												Computed:    true,
+											},
+											"count": schema.Int64Attribute{
+												Description: "Number of occurrences of the value.",
+												Computed:    true,
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
 	}
 }
 
 // factsheetDataSourceModel maps the data source schema data.
 type factsheetDataSourceModel struct {
-	ID       types.String           `tfsdk:"id"`
-	Metadata factsheetMetadataModel `tfsdk:"metadata"`
-	Columns  []factsheetColumnModel `tfsdk:"columns"`
+	ID             types.String           `tfsdk:"id"`
+	Metadata       factsheetMetadataModel `tfsdk:"metadata"`
+	Columns        []factsheetColumnModel `tfsdk:"columns"`
+	Version        types.String           `tfsdk:"version"`
+	IncludeProfile types.Bool             `tfsdk:"include_profile"`
+	Profile        *factsheetProfileModel `tfsdk:"profile"`
+	Timeouts       timeouts.Value         `tfsdk:"timeouts"`
 }
 
 // factsheetModel maps factsheet schema data.
 type factsheetMetadataModel struct {
-	Name         types.String                `tfsdk:"name"`
-	Uri          types.String                `tfsdk:"uri"`
-	ConnectionId types.String                `tfsdk:"connection_id"`
-	Descriptions []factsheetDescriptionModel `tfsdk:"descriptions"`
+	Name           types.String                `tfsdk:"name"`
+	Uri            types.String                `tfsdk:"uri"`
+	Type           types.String                `tfsdk:"type"`
+	ConnectionId   types.String                `tfsdk:"connection_id"`
+	ConnectionType types.String                `tfsdk:"connection_type"`
+	ColCount       types.Int64                 `tfsdk:"col_count"`
+	RowCount       types.Int64                 `tfsdk:"row_count"`
+	SampleRowCount types.Int64                 `tfsdk:"sample_row_count"`
+	Sampled        types.Bool                  `tfsdk:"sampled"`
+	UniqueKeys     []factsheetUniqueKeyModel   `tfsdk:"unique_keys"`
+	Properties     []factsheetPropertyModel    `tfsdk:"properties"`
+	Descriptions   []factsheetDescriptionModel `tfsdk:"descriptions"`
+	GlossaryTerms  []factsheetTermModel        `tfsdk:"glossary_terms"`
+	Tags           []factsheetTagModel         `tfsdk:"tags"`
 }
 
 type factsheetColumnModel struct {
-	Name         types.String                `tfsdk:"name"`
-	Type         types.String                `tfsdk:"type"`
-	Descriptions []factsheetDescriptionModel `tfsdk:"descriptions"`
+	Name          types.String                 `tfsdk:"name"`
+	Type          types.String                 `tfsdk:"type"`
+	TemplateType  types.String                 `tfsdk:"template_type"`
+	Length        types.Int64                  `tfsdk:"length"`
+	Precision     types.Int64                  `tfsdk:"precision"`
+	Scale         types.Int64                  `tfsdk:"scale"`
+	UniqueGroups  types.String                 `tfsdk:"unique_groups"`
+	Properties    []factsheetPropertyModel     `tfsdk:"properties"`
+	Descriptions  []factsheetDescriptionModel  `tfsdk:"descriptions"`
+	GlossaryTerms []factsheetTermModel         `tfsdk:"glossary_terms"`
+	Tags          []factsheetTagModel          `tfsdk:"tags"`
+	Profile       *factsheetColumnProfileModel `tfsdk:"profile"`
+}
+
+// factsheetProfileModel maps factsheet profile schema data.
+type factsheetProfileModel struct {
+	RowCount       types.Int64  `tfsdk:"row_count"`
+	SampleRowCount types.Int64  `tfsdk:"sample_row_count"`
+	Timestamp      types.String `tfsdk:"timestamp"`
+}
+
+// factsheetColumnProfileModel maps factsheet column profile schema data.
+type factsheetColumnProfileModel struct {
+	NullCount     types.Int64              `tfsdk:"null_count"`
+	DistinctCount types.Int64              `tfsdk:"distinct_count"`
+	Min           types.String             `tfsdk:"min"`
+	Max           types.String             `tfsdk:"max"`
+	TopValues     []factsheetTopValueModel `tfsdk:"top_values"`
+}
+
+// factsheetTopValueModel maps factsheet top value schema data.
+type factsheetTopValueModel struct {
+	Value types.String `tfsdk:"value"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+// factsheetUniqueKeyModel maps factsheet unique key schema data.
+type factsheetUniqueKeyModel struct {
+	AttributeReferences []types.String `tfsdk:"attribute_references"`
+}
+
+// factsheetPropertyModel maps factsheet property schema data.
+type factsheetPropertyModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+// factsheetTermModel maps factsheet glossary term schema data.
+type factsheetTermModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	GlossaryId types.String `tfsdk:"glossary_id"`
+}
+
+// factsheetTagModel maps factsheet tag schema data.
+type factsheetTagModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	HierarchyId types.String `tfsdk:"hierarchy_id"`
 }
 
 // factsheetDescriptionModel maps factsheet description schema data.
@@ -170,24 +432,78 @@ func (d *factsheetDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// 	return
 	// }
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	factsheet, err := d.client.GetFactsheet(
+		ctx,
 		state.Metadata.ConnectionId.ValueString(),
 		state.Metadata.Uri.ValueString(),
+		state.IncludeProfile.ValueBool(),
 	)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read SAP DI factsheets",
-			err.Error(),
+	if sap_di.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("metadata").AtName("uri"),
+			"SAP DI Factsheet Not Found",
+			fmt.Sprintf(
+				"Dataset %s not found in connection %s. Ensure the dataset exists and has been published to the catalog.\n\n"+
+					"SAP DI Client Error: %s",
+				state.Metadata.Uri.ValueString(),
+				state.Metadata.ConnectionId.ValueString(),
+				err.Error(),
+			),
 		)
 		return
 	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI factsheets", err)
+		return
+	}
 
 	// Map response body to model
 	state.Metadata = factsheetMetadataModel{
-		Name:         types.StringValue(factsheet.Metadata.Name),
-		Uri:          types.StringValue(factsheet.Metadata.Uri),
-		ConnectionId: types.StringValue(factsheet.Metadata.ConnectionId),
-		Descriptions: []factsheetDescriptionModel{},
+		Name:           types.StringValue(factsheet.Metadata.Name),
+		Uri:            types.StringValue(factsheet.Metadata.Uri),
+		Type:           types.StringValue(factsheet.Metadata.Type),
+		ConnectionId:   types.StringValue(factsheet.Metadata.ConnectionId),
+		ConnectionType: types.StringValue(factsheet.Metadata.ConnectionType),
+		ColCount:       types.Int64Value(factsheet.Metadata.ColCount),
+		RowCount:       types.Int64Value(factsheet.Metadata.RowCount),
+		SampleRowCount: types.Int64Value(factsheet.Metadata.SampleRowCount),
+		Sampled:        types.BoolValue(factsheet.Metadata.Sampled),
+		UniqueKeys:     []factsheetUniqueKeyModel{},
+		Properties:     factsheetProperties(factsheet.Metadata.Properties),
+		Descriptions:   []factsheetDescriptionModel{},
+		GlossaryTerms:  factsheetTerms(factsheet.Metadata.Terms),
+		Tags:           factsheetTags(factsheet.Metadata.Tags),
+	}
+	state.Version = types.StringValue(factsheet.Version)
+	state.Profile = nil
+
+	if state.IncludeProfile.ValueBool() && factsheet.Metadata.Profile != nil {
+		state.Profile = &factsheetProfileModel{
+			RowCount:       types.Int64Value(factsheet.Metadata.Profile.RowCount),
+			SampleRowCount: types.Int64Value(factsheet.Metadata.Profile.SampleRowCount),
+			Timestamp:      types.StringValue(factsheet.Metadata.Profile.Timestamp),
+		}
+	}
+
+	for _, key := range factsheet.Metadata.UniqueKeys {
+		uniqueKey := factsheetUniqueKeyModel{
+			AttributeReferences: []types.String{},
+		}
+
+		for _, ref := range key.AttributeReferences {
+			uniqueKey.AttributeReferences = append(uniqueKey.AttributeReferences, types.StringValue(ref))
+		}
+
+		state.Metadata.UniqueKeys = append(state.Metadata.UniqueKeys, uniqueKey)
 	}
 
 	for _, desc := range factsheet.Metadata.Descriptions {
@@ -200,9 +516,17 @@ func (d *factsheetDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	for _, column := range factsheet.Columns {
 		col := factsheetColumnModel{
-			Name:         types.StringValue(column.Name),
-			Type:         types.StringValue(column.Type),
-			Descriptions: []factsheetDescriptionModel{},
+			Name:          types.StringValue(column.Name),
+			Type:          types.StringValue(column.Type),
+			TemplateType:  types.StringValue(column.TemplateType),
+			Length:        types.Int64PointerValue(column.Length),
+			Precision:     types.Int64PointerValue(column.Precision),
+			Scale:         types.Int64PointerValue(column.Scale),
+			UniqueGroups:  types.StringValue(column.UniqueGroups),
+			Properties:    factsheetProperties(column.Properties),
+			Descriptions:  []factsheetDescriptionModel{},
+			GlossaryTerms: factsheetTerms(column.Terms),
+			Tags:          factsheetTags(column.Tags),
 		}
 
 		for _, desc := range column.Descriptions {
@@ -213,15 +537,73 @@ func (d *factsheetDataSource) Read(ctx context.Context, req datasource.ReadReque
 			})
 		}
 
+		if state.IncludeProfile.ValueBool() && column.Profile != nil {
+			col.Profile = &factsheetColumnProfileModel{
+				NullCount:     types.Int64PointerValue(column.Profile.NullCount),
+				DistinctCount: types.Int64PointerValue(column.Profile.DistinctCount),
+				Min:           types.StringValue(valueString(column.Profile.Min)),
+				Max:           types.StringValue(valueString(column.Profile.Max)),
+				TopValues:     []factsheetTopValueModel{},
+			}
+
+			for _, top := range column.Profile.TopValues {
+				col.Profile.TopValues = append(col.Profile.TopValues, factsheetTopValueModel{
+					Value: types.StringValue(valueString(top.Value)),
+					Count: types.Int64Value(top.Count),
+				})
+			}
+		}
+
 		state.Columns = append(state.Columns, col)
 	}
 
 	state.ID = types.StringValue("placeholder")
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// factsheetProperties maps factsheet properties to their model.
+func factsheetProperties(properties []sap_di.FactsheetProperty) []factsheetPropertyModel {
+	models := []factsheetPropertyModel{}
+	for _, property := range properties {
+		models = append(models, factsheetPropertyModel{
+			Name:  types.StringValue(property.Name),
+			Value: types.StringValue(valueString(property.Value)),
+		})
+	}
+
+	return models
+}
+
+// factsheetTerms maps assigned glossary terms to their model.
+func factsheetTerms(terms []sap_di.FactsheetTerm) []factsheetTermModel {
+	models := []factsheetTermModel{}
+	for _, term := range terms {
+		models = append(models, factsheetTermModel{
+			ID:         types.StringValue(term.Id),
+			Name:       types.StringValue(term.Name),
+			GlossaryId: types.StringValue(term.GlossaryId),
+		})
+	}
+
+	return models
+}
+
+// factsheetTags maps assigned tags to their model.
+func factsheetTags(tags []sap_di.FactsheetTag) []factsheetTagModel {
+	models := []factsheetTagModel{}
+	for _, tag := range tags {
+		models = append(models, factsheetTagModel{
+			ID:          types.StringValue(tag.Id),
+			Name:        types.StringValue(tag.Name),
+			HierarchyId: types.StringValue(tag.HierarchyId),
+		})
+	}
+
+	return models
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -27,6 +28,15 @@ func TestAccFactsheetsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.descriptions.0.origin", "REMOTE"),
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.descriptions.0.type", "SHORT"),
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.descriptions.0.value", "Characteristic"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.type", "TABLE"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.connection_type", "ABAP"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.col_count", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.row_count", "0"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.sample_row_count", "0"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.sampled", "false"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.unique_keys.#", "0"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.properties.#", "0"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "version", "1.0.0"),
 
 					// Verify number of columns returned
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.#", "2"),
@@ -37,11 +47,75 @@ func TestAccFactsheetsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.descriptions.0.origin", "REMOTE"),
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.descriptions.0.type", "SHORT"),
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.descriptions.0.value", "Client"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.template_type", "string"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.length", "3"),
+					resource.TestCheckNoResourceAttr("data.sapdi_factsheet.test", "columns.0.precision"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.unique_groups", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.properties.#", "0"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.1.template_type", "int16"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.1.length", "2"),
+
+					// Verify assigned glossary terms
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.glossary_terms.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.glossary_terms.0.name", "Material"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.glossary_terms.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.glossary_terms.0.id", "TERM_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.glossary_terms.0.glossary_id", "GLOSSARY_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.1.glossary_terms.#", "0"),
+
+					// Verify assigned tags
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "metadata.tags.0.name", "PII"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.tags.0.id", "TAG_0002"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.tags.0.hierarchy_id", "HIER_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.1.tags.#", "0"),
+
+					// Verify profile is only fetched on request
+					resource.TestCheckNoResourceAttr("data.sapdi_factsheet.test", "profile.row_count"),
+					resource.TestCheckNoResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.null_count"),
 
 					// Verify placeholder id attribute
 					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "id", "placeholder"),
 				),
 			},
+			// Profile testing
+			{
+				Config: providerConfig + `data "sapdi_factsheet" "test" {
+					metadata = {
+						uri = "/XYZ/012/ABCD"
+						connection_id = "P40_XYZ"
+					}
+					include_profile = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "profile.row_count", "1250"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "profile.sample_row_count", "1250"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "profile.timestamp", "2023-11-20T08:15:00Z"),
+
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.null_count", "0"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.distinct_count", "3"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.min", "000"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.max", "100"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.top_values.#", "3"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.top_values.0.value", "100"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.0.profile.top_values.0.count", "1040"),
+
+					// Verify numeric statistics are rendered as strings
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.1.profile.min", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_factsheet.test", "columns.1.profile.top_values.0.value", "10"),
+				),
+			},
+			// Missing dataset testing
+			{
+				Config: providerConfig + `data "sapdi_factsheet" "test" {
+					metadata = {
+						uri = "/XYZ/012/MISSING"
+						connection_id = "P40_XYZ"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Dataset /XYZ/012/MISSING not found in connection P40_XYZ"),
+			},
 		},
 	})
 }
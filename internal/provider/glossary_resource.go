@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &glossaryResource{}
+	_ resource.ResourceWithConfigure   = &glossaryResource{}
+	_ resource.ResourceWithImportState = &glossaryResource{}
+)
+
+// NewGlossaryResource is a helper function to simplify the provider implementation.
+func NewGlossaryResource() resource.Resource {
+	return &glossaryResource{}
+}
+
+// glossaryResource is the resource implementation.
+type glossaryResource struct {
+	client *sap_di.Client
+}
+
+// glossaryResourceModel maps the resource schema data.
+type glossaryResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *glossaryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Glossary resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Glossary resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *glossaryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glossary"
+}
+
+// Schema defines the schema for the resource.
+func (r *glossaryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a business glossary in the Metadata Explorer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the glossary.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the glossary.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the glossary.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *glossaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan glossaryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI glossary", map[string]any{"name": plan.Name.ValueString()})
+
+	created, err := r.client.CreateGlossary(ctx, plan.toGlossary())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI glossary", fmt.Errorf("could not create glossary: %w", err))
+		return
+	}
+
+	plan.fromGlossary(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *glossaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state glossaryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	glossary, err := r.client.GetGlossary(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI glossary not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI glossary", fmt.Errorf("could not read SAP DI glossary ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	state.fromGlossary(glossary)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *glossaryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan glossaryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateGlossary(ctx, plan.toGlossary())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI glossary", fmt.Errorf("could not update glossary: %w", err))
+		return
+	}
+
+	plan.fromGlossary(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *glossaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state glossaryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGlossary(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI glossary", fmt.Errorf("could not delete glossary: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing glossary by its ID.
+func (r *glossaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toGlossary converts the resource model into a SAP DI glossary.
+func (m glossaryResourceModel) toGlossary() sap_di.Glossary {
+	return sap_di.Glossary{
+		Id:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// fromGlossary maps a SAP DI glossary onto the resource model.
+func (m *glossaryResourceModel) fromGlossary(glossary *sap_di.Glossary) {
+	m.ID = types.StringValue(glossary.Id)
+	m.Name = types.StringValue(glossary.Name)
+	m.Description = types.StringValue(glossary.Description)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGlossaryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_glossary" "test" {
+					name        = "Finance"
+					description = "Business terms of the finance department"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_glossary.test", "id", "GLOSSARY_0001"),
+					resource.TestCheckResourceAttr("sapdi_glossary.test", "name", "Finance"),
+					resource.TestCheckResourceAttr("sapdi_glossary.test", "description", "Business terms of the finance department"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_glossary.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_glossary" "test" {
+					name = "Finance and Controlling"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_glossary.test", "name", "Finance and Controlling"),
+
+					// Verify the removed description is cleared
+					resource.TestCheckResourceAttr("sapdi_glossary.test", "description", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &glossaryTermAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &glossaryTermAssignmentResource{}
+	_ resource.ResourceWithImportState = &glossaryTermAssignmentResource{}
+)
+
+// NewGlossaryTermAssignmentResource is a helper function to simplify the provider implementation.
+func NewGlossaryTermAssignmentResource() resource.Resource {
+	return &glossaryTermAssignmentResource{}
+}
+
+// glossaryTermAssignmentResource is the resource implementation.
+type glossaryTermAssignmentResource struct {
+	client *sap_di.Client
+}
+
+// glossaryTermAssignmentResourceModel maps the resource schema data.
+type glossaryTermAssignmentResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	TermId       types.String `tfsdk:"term_id"`
+	ConnectionId types.String `tfsdk:"connection_id"`
+	DatasetUri   types.String `tfsdk:"dataset_uri"`
+	ColumnName   types.String `tfsdk:"column_name"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *glossaryTermAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Glossary Term Assignment resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Glossary Term Assignment resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *glossaryTermAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glossary_term_assignment"
+}
+
+// Schema defines the schema for the resource.
+func (r *glossaryTermAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a glossary term to a dataset or to one of its columns.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the assignment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"term_id": schema.StringAttribute{
+				Description: "ID of the assigned glossary term.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Connection ID of the dataset, e.g. P40_XYZ.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset_uri": schema.StringAttribute{
+				Description: "URI of the dataset, e.g. /XYZ/012/ABCD.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column_name": schema.StringAttribute{
+				Description: "Name of the column the term is assigned to, e.g. MANDT. The term is assigned to the dataset itself if not set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *glossaryTermAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan glossaryTermAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignment := plan.toAssignment()
+
+	tflog.Info(ctx, "Creating SAP DI glossary term assignment", map[string]any{
+		"term_id":       assignment.TermId,
+		"connection_id": assignment.ConnectionId,
+		"dataset_uri":   assignment.QualifiedName,
+		"column_name":   assignment.ColumnName,
+	})
+
+	created, err := r.client.CreateGlossaryTermAssignment(ctx, assignment)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI glossary term assignment", fmt.Errorf("could not assign glossary term: %w", err))
+		return
+	}
+
+	plan.ID = types.StringValue(created.Id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *glossaryTermAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state glossaryTermAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignments, err := r.client.ListGlossaryTermAssignments(ctx, state.TermId.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI glossary term assignment", fmt.Errorf("could not read assignments of SAP DI glossary term ID %s: %w", state.TermId.ValueString(), err))
+		return
+	}
+
+	// Assignments are looked up by what they link, as imports do not know the assignment ID.
+	wanted := state.toAssignment()
+	for _, assignment := range assignments {
+		if assignment.ConnectionId == wanted.ConnectionId &&
+			assignment.QualifiedName == wanted.QualifiedName &&
+			assignment.ColumnName == wanted.ColumnName {
+			state.ID = types.StringValue(assignment.Id)
+
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	tflog.Warn(ctx, "SAP DI glossary term assignment not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+// Update is never called, as every change replaces the assignment.
+func (r *glossaryTermAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan glossaryTermAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *glossaryTermAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state glossaryTermAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGlossaryTermAssignment(ctx, state.TermId.ValueString(), state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI glossary term assignment", fmt.Errorf("could not remove glossary term assignment: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing assignment by term ID, connection ID,
+// dataset URI and optionally column name, separated by commas.
+func (r *glossaryTermAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) < 3 || len(parts) > 4 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: term_id,connection_id,dataset_uri[,column_name]. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("term_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_uri"), parts[2])...)
+	if len(parts) == 4 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("column_name"), parts[3])...)
+	}
+}
+
+// toAssignment converts the resource model into a SAP DI glossary term assignment.
+func (m glossaryTermAssignmentResourceModel) toAssignment() sap_di.GlossaryTermAssignment {
+	return sap_di.GlossaryTermAssignment{
+		Id:            m.ID.ValueString(),
+		TermId:        m.TermId.ValueString(),
+		ConnectionId:  m.ConnectionId.ValueString(),
+		QualifiedName: m.DatasetUri.ValueString(),
+		ColumnName:    m.ColumnName.ValueString(),
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGlossaryTermAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_glossary_term_assignment" "test" {
+					term_id       = "TERM_0001"
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					column_name   = "MANDT"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_glossary_term_assignment.test", "id", "ASSIGN_0001"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term_assignment.test", "term_id", "TERM_0001"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term_assignment.test", "dataset_uri", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term_assignment.test", "column_name", "MANDT"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_glossary_term_assignment.test",
+				ImportState:       true,
+				ImportStateId:     "TERM_0001,P40_XYZ,/XYZ/012/ABCD,MANDT",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &glossaryTermResource{}
+	_ resource.ResourceWithConfigure   = &glossaryTermResource{}
+	_ resource.ResourceWithImportState = &glossaryTermResource{}
+)
+
+// NewGlossaryTermResource is a helper function to simplify the provider implementation.
+func NewGlossaryTermResource() resource.Resource {
+	return &glossaryTermResource{}
+}
+
+// glossaryTermResource is the resource implementation.
+type glossaryTermResource struct {
+	client *sap_di.Client
+}
+
+// glossaryTermResourceModel maps the resource schema data.
+type glossaryTermResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	GlossaryId       types.String `tfsdk:"glossary_id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Synonyms         types.Set    `tfsdk:"synonyms"`
+	CustomAttributes types.Map    `tfsdk:"custom_attributes"`
+	RelatedTermIds   types.Set    `tfsdk:"related_term_ids"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *glossaryTermResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Glossary Term resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Glossary Term resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *glossaryTermResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glossary_term"
+}
+
+// Schema defines the schema for the resource.
+func (r *glossaryTermResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a term of a business glossary in the Metadata Explorer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the term.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"glossary_id": schema.StringAttribute{
+				Description: "ID of the glossary containing the term.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the term.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the term.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"synonyms": schema.SetAttribute{
+				Description: "Synonyms of the term.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"custom_attributes": schema.MapAttribute{
+				Description: "Custom attributes of the term, e.g. owner or data domain.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+			"related_term_ids": schema.SetAttribute{
+				Description: "IDs of related terms.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *glossaryTermResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan glossaryTermResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	term, diags := plan.toGlossaryTerm(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI glossary term", map[string]any{
+		"glossary_id": term.GlossaryId,
+		"name":        term.Name,
+	})
+
+	created, err := r.client.CreateGlossaryTerm(ctx, *term)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI glossary term", fmt.Errorf("could not create glossary term: %w", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromGlossaryTerm(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *glossaryTermResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state glossaryTermResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	term, err := r.client.GetGlossaryTerm(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI glossary term not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI glossary term", fmt.Errorf("could not read SAP DI glossary term ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromGlossaryTerm(ctx, term)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *glossaryTermResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan glossaryTermResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	term, diags := plan.toGlossaryTerm(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateGlossaryTerm(ctx, *term)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI glossary term", fmt.Errorf("could not update glossary term: %w", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromGlossaryTerm(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *glossaryTermResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state glossaryTermResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGlossaryTerm(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI glossary term", fmt.Errorf("could not delete glossary term: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing glossary term by its ID.
+func (r *glossaryTermResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toGlossaryTerm converts the resource model into a SAP DI glossary term.
+func (m glossaryTermResourceModel) toGlossaryTerm(ctx context.Context) (*sap_di.GlossaryTerm, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	term := &sap_di.GlossaryTerm{
+		Id:               m.ID.ValueString(),
+		GlossaryId:       m.GlossaryId.ValueString(),
+		Name:             m.Name.ValueString(),
+		Description:      m.Description.ValueString(),
+		Synonyms:         []string{},
+		CustomAttributes: map[string]string{},
+		RelatedTerms:     []string{},
+	}
+
+	if !m.Synonyms.IsNull() && !m.Synonyms.IsUnknown() {
+		diags.Append(m.Synonyms.ElementsAs(ctx, &term.Synonyms, false)...)
+	}
+
+	if !m.CustomAttributes.IsNull() && !m.CustomAttributes.IsUnknown() {
+		diags.Append(m.CustomAttributes.ElementsAs(ctx, &term.CustomAttributes, false)...)
+	}
+
+	if !m.RelatedTermIds.IsNull() && !m.RelatedTermIds.IsUnknown() {
+		diags.Append(m.RelatedTermIds.ElementsAs(ctx, &term.RelatedTerms, false)...)
+	}
+
+	return term, diags
+}
+
+// fromGlossaryTerm maps a SAP DI glossary term onto the resource model.
+func (m *glossaryTermResourceModel) fromGlossaryTerm(ctx context.Context, term *sap_di.GlossaryTerm) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	m.ID = types.StringValue(term.Id)
+	m.GlossaryId = types.StringValue(term.GlossaryId)
+	m.Name = types.StringValue(term.Name)
+	m.Description = types.StringValue(term.Description)
+
+	synonyms := term.Synonyms
+	if synonyms == nil {
+		synonyms = []string{}
+	}
+	m.Synonyms, d = types.SetValueFrom(ctx, types.StringType, synonyms)
+	diags.Append(d...)
+
+	customAttributes := term.CustomAttributes
+	if customAttributes == nil {
+		customAttributes = map[string]string{}
+	}
+	m.CustomAttributes, d = types.MapValueFrom(ctx, types.StringType, customAttributes)
+	diags.Append(d...)
+
+	relatedTerms := term.RelatedTerms
+	if relatedTerms == nil {
+		relatedTerms = []string{}
+	}
+	m.RelatedTermIds, d = types.SetValueFrom(ctx, types.StringType, relatedTerms)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGlossaryTermResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_glossary_term" "test" {
+					glossary_id = "GLOSSARY_0001"
+					name        = "Company Code"
+					description = "Smallest organizational unit with a complete self-contained set of accounts"
+					synonyms    = ["BUKRS", "CoCd"]
+					custom_attributes = {
+						owner  = "finance-stewards"
+						domain = "Accounting"
+					}
+					related_term_ids = ["TERM_0002"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "id", "TERM_0001"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "glossary_id", "GLOSSARY_0001"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "name", "Company Code"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "synonyms.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sapdi_glossary_term.test", "synonyms.*", "BUKRS"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "custom_attributes.%", "2"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "custom_attributes.domain", "Accounting"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "related_term_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sapdi_glossary_term.test", "related_term_ids.*", "TERM_0002"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_glossary_term.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_glossary_term" "test" {
+					glossary_id      = "GLOSSARY_0001"
+					name             = "Company Code"
+					related_term_ids = ["TERM_0002"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "name", "Company Code"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "related_term_ids.#", "1"),
+
+					// Verify removed attributes are cleared
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "description", ""),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "synonyms.#", "0"),
+					resource.TestCheckResourceAttr("sapdi_glossary_term.test", "custom_attributes.%", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
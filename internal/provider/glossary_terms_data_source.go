@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &glossaryTermsDataSource{}
+	_ datasource.DataSourceWithConfigure = &glossaryTermsDataSource{}
+)
+
+// NewGlossaryTermsDataSource is a helper function to simplify the provider implementation.
+func NewGlossaryTermsDataSource() datasource.DataSource {
+	return &glossaryTermsDataSource{}
+}
+
+// glossaryTermsDataSource is the data source implementation.
+type glossaryTermsDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *glossaryTermsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Glossary Terms data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Glossary Terms data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *glossaryTermsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glossary_terms"
+}
+
+// Schema defines the schema for the data source.
+func (d *glossaryTermsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the terms of all business glossaries, optionally filtered by glossary.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"glossary_id": schema.StringAttribute{
+				Description: "Only return terms of this glossary.",
+				Optional:    true,
+			},
+			"terms": schema.ListNestedAttribute{
+				Description: "List of glossary terms.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the term.",
+							Computed:    true,
+						},
+						"glossary_id": schema.StringAttribute{
+							Description: "ID of the glossary containing the term.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the term.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the term.",
+							Computed:    true,
+						},
+						"synonyms": schema.ListAttribute{
+							Description: "Synonyms of the term.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"custom_attributes": schema.MapAttribute{
+							Description: "Custom attributes of the term.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"related_term_ids": schema.ListAttribute{
+							Description: "IDs of related terms.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// glossaryTermsDataSourceModel maps the data source schema data.
+type glossaryTermsDataSourceModel struct {
+	ID         types.String         `tfsdk:"id"`
+	GlossaryId types.String         `tfsdk:"glossary_id"`
+	Terms      []glossaryTermsModel `tfsdk:"terms"`
+	Timeouts   timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// glossaryTermsModel maps glossary term schema data.
+type glossaryTermsModel struct {
+	ID               types.String            `tfsdk:"id"`
+	GlossaryId       types.String            `tfsdk:"glossary_id"`
+	Name             types.String            `tfsdk:"name"`
+	Description      types.String            `tfsdk:"description"`
+	Synonyms         []types.String          `tfsdk:"synonyms"`
+	CustomAttributes map[string]types.String `tfsdk:"custom_attributes"`
+	RelatedTermIds   []types.String          `tfsdk:"related_term_ids"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *glossaryTermsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state glossaryTermsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Glossary Terms data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	terms, err := d.client.ListGlossaryTerms(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI glossary terms", err)
+		return
+	}
+
+	// Map response body to model
+	state.Terms = []glossaryTermsModel{}
+	for _, term := range terms {
+		if !state.GlossaryId.IsNull() && term.GlossaryId != state.GlossaryId.ValueString() {
+			continue
+		}
+
+		t := glossaryTermsModel{
+			ID:               types.StringValue(term.Id),
+			GlossaryId:       types.StringValue(term.GlossaryId),
+			Name:             types.StringValue(term.Name),
+			Description:      types.StringValue(term.Description),
+			Synonyms:         []types.String{},
+			CustomAttributes: map[string]types.String{},
+			RelatedTermIds:   []types.String{},
+		}
+
+		for _, synonym := range term.Synonyms {
+			t.Synonyms = append(t.Synonyms, types.StringValue(synonym))
+		}
+
+		for name, value := range term.CustomAttributes {
+			t.CustomAttributes[name] = types.StringValue(value)
+		}
+
+		for _, relatedTerm := range term.RelatedTerms {
+			t.RelatedTermIds = append(t.RelatedTermIds, types.StringValue(relatedTerm))
+		}
+
+		state.Terms = append(state.Terms, t)
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGlossaryTermsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_glossary_terms" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of terms returned
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.#", "3"),
+					// Verify the first term to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.id", "TERM_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.glossary_id", "GLOSSARY_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.name", "Company Code"),
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.synonyms.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.synonyms.0", "BUKRS"),
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.custom_attributes.owner", "finance-stewards"),
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.related_term_ids.0", "TERM_0002"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "id", "placeholder"),
+				),
+			},
+			// Filter by glossary
+			{
+				Config: providerConfig + `data "sapdi_glossary_terms" "test" {
+					glossary_id = "GLOSSARY_0002"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_glossary_terms.test", "terms.0.name", "Material"),
+				),
+			},
+		},
+	})
+}
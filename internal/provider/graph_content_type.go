@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = graphContentType{}
+	_ xattr.TypeWithValidate                     = graphContentType{}
+	_ basetypes.StringValuableWithSemanticEquals = graphContentValue{}
+)
+
+// graphContentType is a string type holding the JSON document of a graph.
+type graphContentType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is a graphContentType.
+func (t graphContentType) Equal(o attr.Type) bool {
+	other, ok := o.(graphContentType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable name of the type.
+func (t graphContentType) String() string {
+	return "graphContentType"
+}
+
+// Validate rejects values which are not a JSON object.
+func (t graphContentType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.IsNull() || !in.IsKnown() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(path, "Invalid Graph Value", fmt.Sprintf("Could not read the graph as string: %s", err))
+		return diags
+	}
+
+	_, err = sap_di.NormalizeGraph([]byte(value))
+	if err != nil {
+		diags.AddAttributeError(path, "Invalid Graph JSON", fmt.Sprintf("The graph must be a JSON object as exported by the Modeler: %s", err))
+	}
+
+	return diags
+}
+
+// ValueFromString wraps a string value as graphContentValue.
+func (t graphContentType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return graphContentValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value into a graphContentValue.
+func (t graphContentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ValueType returns the value type of graphContentType.
+func (t graphContentType) ValueType(_ context.Context) attr.Value {
+	return graphContentValue{}
+}
+
+// graphContentValue is the JSON document of a graph. Documents differing only
+// in key order, whitespace or diagram layout are semantically equal, so the
+// formatting returned by the repository does not cause a diff.
+type graphContentValue struct {
+	basetypes.StringValue
+}
+
+// Equal returns true if the given value is the same graphContentValue.
+func (v graphContentValue) Equal(o attr.Value) bool {
+	other, ok := o.(graphContentValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the graphContentType.
+func (v graphContentValue) Type(_ context.Context) attr.Type {
+	return graphContentType{}
+}
+
+// StringSemanticEquals compares the normalized graph documents.
+func (v graphContentValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(graphContentValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	current, err := sap_di.NormalizeGraph([]byte(v.ValueString()))
+	if err != nil {
+		return false, diags
+	}
+
+	updated, err := sap_di.NormalizeGraph([]byte(newValue.ValueString()))
+	if err != nil {
+		return false, diags
+	}
+
+	return current == updated, diags
+}
+
+// newGraphContentValue returns a known graphContentValue.
+func newGraphContentValue(value string) graphContentValue {
+	return graphContentValue{StringValue: basetypes.NewStringValue(value)}
+}
@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+const (
+	// defaultGraphStartTimeout is the create timeout of graph executions without a timeouts block.
+	defaultGraphStartTimeout = 30 * time.Minute
+
+	// defaultGraphStopTimeout is the delete timeout of graph executions without a timeouts block.
+	defaultGraphStopTimeout = 5 * time.Minute
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &graphExecutionResource{}
+	_ resource.ResourceWithConfigure  = &graphExecutionResource{}
+	_ resource.ResourceWithModifyPlan = &graphExecutionResource{}
+)
+
+// NewGraphExecutionResource is a helper function to simplify the provider implementation.
+func NewGraphExecutionResource() resource.Resource {
+	return &graphExecutionResource{}
+}
+
+// graphExecutionResource is the resource implementation.
+type graphExecutionResource struct {
+	client *sap_di.Client
+}
+
+// graphExecutionResourceModel maps the resource schema data.
+type graphExecutionResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	GraphName     types.String   `tfsdk:"graph_name"`
+	Substitutions types.Map      `tfsdk:"substitutions"`
+	DesiredState  types.String   `tfsdk:"desired_state"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	Status        types.String   `tfsdk:"status"`
+	Message       types.String   `tfsdk:"message"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *graphExecutionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Graph Execution resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Graph Execution resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *graphExecutionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_execution"
+}
+
+// Schema defines the schema for the resource.
+func (r *graphExecutionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a graph in the Modeler and waits until it is running or completed. " +
+			"If it did not get there when the timeout is reached, the next apply waits for it again. " +
+			"Destroying the resource stops the graph if it is still running.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Handle of the graph execution.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_name": schema.StringAttribute{
+				Description: "Name of the graph to start, e.g. com.mycompany.ingest.orders.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"substitutions": schema.MapAttribute{
+				Description: "Configuration substitutions of the graph, replacing ${NAME} placeholders in operator configurations.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"desired_state": schema.StringAttribute{
+				Description: "State to wait for, either running or completed. Defaults to running. " +
+					"A running graph which died is started again on the next apply.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(sap_di.GraphStatusRunning),
+				Validators: []validator.String{
+					stringvalidator.OneOf(sap_di.GraphStatusRunning, sap_di.GraphStatusCompleted),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which restart the graph when changed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the graph execution, e.g. running, completed or dead.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "Error message of a dead graph execution.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create starts the graph and waits for the desired state.
+func (r *graphExecutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan graphExecutionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultGraphStartTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	substitutions := map[string]string{}
+	resp.Diagnostics.Append(plan.Substitutions.ElementsAs(ctx, &substitutions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := plan.DesiredState.ValueString()
+
+	tflog.Info(ctx, "Starting SAP DI graph", map[string]any{
+		"graph_name":    plan.GraphName.ValueString(),
+		"desired_state": desired,
+	})
+
+	execution, err := r.client.StartGraph(ctx, plan.GraphName.ValueString(), substitutions)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Starting SAP DI graph", fmt.Errorf("could not start graph %s: %w", plan.GraphName.ValueString(), err))
+		return
+	}
+
+	// Record the handle before waiting, so a later apply can wait for it again.
+	plan.fromGraphExecution(execution)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForGraphExecution(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *graphExecutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state graphExecutionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	execution, err := r.client.GetGraphExecution(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		// The runtime purges finished executions, which must not start the graph again.
+		tflog.Debug(ctx, "SAP DI graph execution no longer in runtime, keeping state", map[string]any{"id": state.ID.ValueString()})
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI graph execution", fmt.Errorf("could not read SAP DI graph execution %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	if execution.Status == sap_di.GraphStatusDead && state.DesiredState.ValueString() == sap_di.GraphStatusRunning {
+		tflog.Warn(ctx, "SAP DI graph execution died, removing from state", map[string]any{
+			"id":      state.ID.ValueString(),
+			"message": execution.Message,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.fromGraphExecution(execution)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update takes over changed timeouts and waits again for an execution which
+// did not reach the desired state yet, as every other change starts the graph again.
+func (r *graphExecutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan graphExecutionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state graphExecutionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waiting := plan.Status.IsUnknown()
+
+	plan.Status = state.Status
+	plan.Message = state.Message
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !waiting {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultGraphStartTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.waitForGraphExecution(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete stops the graph execution if it did not finish yet.
+func (r *graphExecutionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state graphExecutionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultGraphStopTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	execution, err := r.client.GetGraphExecution(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Stopping SAP DI graph", fmt.Errorf("could not read SAP DI graph execution %s: %w", state.ID.ValueString(), err))
+		return
+	}
+	if execution.Done() {
+		return
+	}
+
+	err = r.client.StopGraphExecution(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Stopping SAP DI graph", fmt.Errorf("could not stop SAP DI graph execution %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	// Waiting for completed returns as soon as the execution is final, stopped graphs end up dead.
+	_, err = r.client.WaitForGraphExecution(ctx, state.ID.ValueString(), sap_di.GraphStatusCompleted)
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Stopping SAP DI graph", fmt.Errorf("graph execution %s did not stop: %w", state.ID.ValueString(), err))
+		return
+	}
+}
+
+// ModifyPlan plans the status of an execution which did not reach the desired
+// state yet as unknown, so the apply waits for it again.
+func (r *graphExecutionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to wait for on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state graphExecutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	execution := sap_di.GraphExecution{Status: state.Status.ValueString()}
+	if execution.Status == state.DesiredState.ValueString() || execution.Done() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("message"), types.StringUnknown())...)
+}
+
+// waitForGraphExecution waits for the execution to reach the desired state
+// and saves it to state. Running out of time only warns, as a tainted
+// execution would be stopped and the graph started again on the next apply.
+func (r *graphExecutionResource) waitForGraphExecution(ctx context.Context, m *graphExecutionResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	desired := m.DesiredState.ValueString()
+
+	execution, err := r.client.WaitForGraphExecution(ctx, m.ID.ValueString(), desired)
+	if err != nil {
+		diags.AddWarning(
+			"SAP DI Graph Not Ready",
+			fmt.Sprintf("Stopped waiting for graph execution %s to reach status %s, the next apply waits for it again: %s", m.ID.ValueString(), desired, err),
+		)
+		return
+	}
+
+	m.fromGraphExecution(execution)
+	diags.Append(state.Set(ctx, m)...)
+	if diags.HasError() {
+		return
+	}
+
+	// A graph which completed already has passed the running state as well.
+	if execution.Status == sap_di.GraphStatusDead {
+		diags.AddError(
+			"SAP DI Graph Failed",
+			fmt.Sprintf(
+				"Graph %s (execution %s) died: %s",
+				m.GraphName.ValueString(),
+				execution.Handle,
+				execution.Message,
+			),
+		)
+	}
+}
+
+// fromGraphExecution maps a SAP DI graph execution onto the resource model.
+func (m *graphExecutionResourceModel) fromGraphExecution(execution *sap_di.GraphExecution) {
+	m.ID = types.StringValue(execution.Handle)
+	m.Status = types.StringValue(execution.Status)
+	m.Message = types.StringValue(execution.Message)
+}
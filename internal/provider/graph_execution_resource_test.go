@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphExecutionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid desired state testing
+			{
+				Config: providerConfig + `resource "sapdi_graph_execution" "test" {
+					graph_name    = "com.mycompany.ingest.orders"
+					desired_state = "finished"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_graph_execution" "test" {
+					graph_name    = "com.mycompany.ingest.orders"
+					desired_state = "completed"
+					substitutions = {
+						SOURCE_PATH = "/orders"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_graph_execution.test", "id", "3f9a1c0d2b7e4e55"),
+					resource.TestCheckResourceAttr("sapdi_graph_execution.test", "graph_name", "com.mycompany.ingest.orders"),
+					resource.TestCheckResourceAttr("sapdi_graph_execution.test", "desired_state", "completed"),
+					resource.TestCheckResourceAttr("sapdi_graph_execution.test", "substitutions.SOURCE_PATH", "/orders"),
+					resource.TestCheckResourceAttr("sapdi_graph_execution.test", "status", "completed"),
+					resource.TestCheckResourceAttr("sapdi_graph_execution.test", "message", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &graphResource{}
+	_ resource.ResourceWithConfigure   = &graphResource{}
+	_ resource.ResourceWithImportState = &graphResource{}
+)
+
+// NewGraphResource is a helper function to simplify the provider implementation.
+func NewGraphResource() resource.Resource {
+	return &graphResource{}
+}
+
+// graphResource is the resource implementation.
+type graphResource struct {
+	client *sap_di.Client
+}
+
+// graphResourceModel maps the resource schema data.
+type graphResourceModel struct {
+	ID      types.String      `tfsdk:"id"`
+	Name    types.String      `tfsdk:"name"`
+	Content graphContentValue `tfsdk:"content"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *graphResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Graph resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Graph resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *graphResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph"
+}
+
+// Schema defines the schema for the resource.
+func (r *graphResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Modeler graph in the vflow repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of the graph.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the graph in the vflow repository, e.g. com.mycompany.ingest.orders.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "JSON document of the graph as exported by the Modeler, e.g. read with file(). " +
+					"Differences in key order, whitespace and diagram layout are ignored.",
+				CustomType: graphContentType{},
+				Required:   true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *graphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan graphResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI graph", map[string]any{"name": plan.Name.ValueString()})
+
+	err := r.client.CreateGraph(ctx, plan.Name.ValueString(), json.RawMessage(plan.Content.ValueString()))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI graph", fmt.Errorf("could not create graph %s: %w", plan.Name.ValueString(), err))
+		return
+	}
+
+	plan.ID = plan.Name
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *graphResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state graphResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graph, err := r.client.GetGraph(ctx, state.Name.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI graph not found, removing from state", map[string]any{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI graph", fmt.Errorf("could not read SAP DI graph %s: %w", state.Name.ValueString(), err))
+		return
+	}
+
+	// Semantic equality keeps the configured formatting unless the graph changed.
+	state.ID = state.Name
+	state.Content = newGraphContentValue(string(graph))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *graphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan graphResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateGraph(ctx, plan.Name.ValueString(), json.RawMessage(plan.Content.ValueString()))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI graph", fmt.Errorf("could not update graph %s: %w", plan.Name.ValueString(), err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *graphResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state graphResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGraph(ctx, state.Name.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI graph", fmt.Errorf("could not delete graph %s: %w", state.Name.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports an existing graph by its name.
+func (r *graphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the repository adds the diagram layout
+			{
+				Config: providerConfig + `resource "sapdi_graph" "test" {
+					name = "com.mycompany.ingest.orders"
+					content = jsonencode({
+						description = "Ingest orders"
+						processes = {
+							reader = {
+								component = "com.sap.storage.read"
+								metadata = {
+									label  = "Read File"
+									config = { path = "/orders" }
+								}
+							}
+							terminal = {
+								component = "com.sap.util.terminal"
+								metadata = {
+									label  = "Terminal"
+									config = {}
+								}
+							}
+						}
+						groups = []
+						connections = [
+							{
+								metadata = {}
+								src      = { port = "file", process = "reader" }
+								tgt      = { port = "in1", process = "terminal" }
+							},
+						]
+						inports    = {}
+						outports   = {}
+						properties = {}
+					})
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_graph.test", "id", "com.mycompany.ingest.orders"),
+					resource.TestCheckResourceAttr("sapdi_graph.test", "name", "com.mycompany.ingest.orders"),
+					// Verify the configured document is kept instead of the one with the layout
+					resource.TestMatchResourceAttr("sapdi_graph.test", "content", regexp.MustCompile(`^\{"connections":\[\{"metadata":\{\},`)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_graph.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported content has the repository formatting.
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lineageDataSource{}
+	_ datasource.DataSourceWithConfigure = &lineageDataSource{}
+)
+
+// NewLineageDataSource is a helper function to simplify the provider implementation.
+func NewLineageDataSource() datasource.DataSource {
+	return &lineageDataSource{}
+}
+
+// lineageDataSource is the data source implementation.
+type lineageDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lineageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Lineage data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Lineage data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *lineageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lineage"
+}
+
+// Schema defines the schema for the data source.
+func (d *lineageDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the upstream and downstream lineage of a published dataset.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Connection ID of the dataset, e.g. P40_XYZ.",
+				Required:    true,
+			},
+			"dataset_uri": schema.StringAttribute{
+				Description: "URI of the dataset, e.g. /XYZ/012/ABCD.",
+				Required:    true,
+			},
+			"direction": schema.StringAttribute{
+				Description: "Direction of the lineage, one of upstream, downstream or both. Defaults to both.",
+				Optional:    true,
+			},
+			"depth": schema.Int64Attribute{
+				Description: "Number of levels to follow from the dataset. Defaults to the full lineage.",
+				Optional:    true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "Datasets, operators and graphs of the lineage, including the dataset itself.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the node, as referenced by edges.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the node, e.g. DATASET, OPERATOR or GRAPH.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the node.",
+							Computed:    true,
+						},
+						"connection_id": schema.StringAttribute{
+							Description: "Connection ID of dataset nodes.",
+							Computed:    true,
+						},
+						"qualified_name": schema.StringAttribute{
+							Description: "Qualified name of the node, which is the dataset URI for datasets and the graph name for graphs.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				Description: "Data flows between the nodes.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "ID of the node the data flows from.",
+							Computed:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "ID of the node the data flows into.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// lineageDataSourceModel maps the data source schema data.
+type lineageDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	ConnectionId types.String       `tfsdk:"connection_id"`
+	DatasetUri   types.String       `tfsdk:"dataset_uri"`
+	Direction    types.String       `tfsdk:"direction"`
+	Depth        types.Int64        `tfsdk:"depth"`
+	Nodes        []lineageNodeModel `tfsdk:"nodes"`
+	Edges        []lineageEdgeModel `tfsdk:"edges"`
+	Timeouts     timeouts.Value     `tfsdk:"timeouts"`
+}
+
+// lineageNodeModel maps lineage node schema data.
+type lineageNodeModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	Name          types.String `tfsdk:"name"`
+	ConnectionId  types.String `tfsdk:"connection_id"`
+	QualifiedName types.String `tfsdk:"qualified_name"`
+}
+
+// lineageEdgeModel maps lineage edge schema data.
+type lineageEdgeModel struct {
+	Source types.String `tfsdk:"source"`
+	Target types.String `tfsdk:"target"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lineageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lineageDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Lineage data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	direction := sap_di.LineageDirectionBoth
+	if !state.Direction.IsNull() {
+		direction = strings.ToUpper(state.Direction.ValueString())
+	}
+	switch direction {
+	case sap_di.LineageDirectionUpstream, sap_di.LineageDirectionDownstream, sap_di.LineageDirectionBoth:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("direction"),
+			"Invalid Lineage Direction",
+			fmt.Sprintf("The direction must be one of upstream, downstream or both, got: %s.", state.Direction.ValueString()),
+		)
+	}
+
+	if !state.Depth.IsNull() && state.Depth.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("depth"),
+			"Invalid Lineage Depth",
+			fmt.Sprintf("The depth must be at least 1, got: %d.", state.Depth.ValueInt64()),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	lineage, err := d.client.GetLineage(
+		ctx,
+		state.ConnectionId.ValueString(),
+		state.DatasetUri.ValueString(),
+		direction,
+		int(state.Depth.ValueInt64()),
+	)
+	if sap_di.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dataset_uri"),
+			"SAP DI Dataset Not Found",
+			fmt.Sprintf(
+				"Dataset %s not found in connection %s. Ensure the dataset exists and has been published to the catalog.\n\n"+
+					"SAP DI Client Error: %s",
+				state.DatasetUri.ValueString(),
+				state.ConnectionId.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI lineage", err)
+		return
+	}
+
+	// Map response body to model
+	state.Nodes = []lineageNodeModel{}
+	for _, node := range lineage.Nodes {
+		state.Nodes = append(state.Nodes, lineageNodeModel{
+			ID:            types.StringValue(node.Id),
+			Type:          types.StringValue(node.Type),
+			Name:          types.StringValue(node.Name),
+			ConnectionId:  types.StringValue(node.ConnectionId),
+			QualifiedName: types.StringValue(node.QualifiedName),
+		})
+	}
+
+	state.Edges = []lineageEdgeModel{}
+	for _, edge := range lineage.Edges {
+		state.Edges = append(state.Edges, lineageEdgeModel{
+			Source: types.StringValue(edge.Source),
+			Target: types.StringValue(edge.Target),
+		})
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLineageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_lineage" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					direction     = "downstream"
+					depth         = 3
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify nodes
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "nodes.#", "4"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "nodes.0.id", "n1"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "nodes.0.type", "DATASET"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "nodes.0.connection_id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "nodes.0.qualified_name", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "nodes.1.type", "GRAPH"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "nodes.1.qualified_name", "com.mycompany.ingest.abcd"),
+
+					// Verify edges
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "edges.#", "3"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "edges.0.source", "n1"),
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "edges.0.target", "n3"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_lineage.test", "id", "placeholder"),
+				),
+			},
+			// Invalid direction
+			{
+				Config: providerConfig + `data "sapdi_lineage" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					direction     = "sideways"
+				}`,
+				ExpectError: regexp.MustCompile("The direction must be one of upstream, downstream or both"),
+			},
+		},
+	})
+}
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// defaultProfilingTimeout is the create timeout of profiling tasks without a timeouts block.
+const defaultProfilingTimeout = 30 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &profilingTaskResource{}
+	_ resource.ResourceWithConfigure  = &profilingTaskResource{}
+	_ resource.ResourceWithModifyPlan = &profilingTaskResource{}
+)
+
+// NewProfilingTaskResource is a helper function to simplify the provider implementation.
+func NewProfilingTaskResource() resource.Resource {
+	return &profilingTaskResource{}
+}
+
+// profilingTaskResource is the resource implementation.
+type profilingTaskResource struct {
+	client *sap_di.Client
+}
+
+// profilingTaskResourceModel maps the resource schema data.
+type profilingTaskResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ConnectionId types.String   `tfsdk:"connection_id"`
+	DatasetUri   types.String   `tfsdk:"dataset_uri"`
+	Triggers     types.Map      `tfsdk:"triggers"`
+	Status       types.String   `tfsdk:"status"`
+	Messages     types.List     `tfsdk:"messages"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *profilingTaskResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Profiling Task resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Profiling Task resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *profilingTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profiling_task"
+}
+
+// Schema defines the schema for the resource.
+func (r *profilingTaskResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts profiling a dataset in the Metadata Explorer and waits for the profiling task to finish. " +
+			"If the task is still running when the timeout is reached, the next apply waits for it again. " +
+			"Destroying the resource keeps the profiling results.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the profiling task.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Connection ID of the dataset, e.g. P40_XYZ.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset_uri": schema.StringAttribute{
+				Description: "URI of the dataset, e.g. /XYZ/012/ABCD.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which start a new profiling task when changed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the profiling task, e.g. COMPLETED or FAILED.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"messages": schema.ListAttribute{
+				Description: "Error messages reported by the profiling task.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// Create starts the profiling task and waits for it to finish.
+func (r *profilingTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan profilingTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultProfilingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Starting SAP DI profiling task", map[string]any{
+		"connection_id": plan.ConnectionId.ValueString(),
+		"dataset_uri":   plan.DatasetUri.ValueString(),
+	})
+
+	task, err := r.client.StartProfiling(ctx, plan.ConnectionId.ValueString(), plan.DatasetUri.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Starting SAP DI profiling task", fmt.Errorf("could not start profiling: %w", err))
+		return
+	}
+
+	// Record the task before waiting, so a later apply can wait for it again.
+	resp.Diagnostics.Append(plan.fromTask(ctx, task)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForTask(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *profilingTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state profilingTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetTask(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		// The monitoring purges old tasks, which must not start profiling again.
+		tflog.Debug(ctx, "SAP DI profiling task no longer in monitoring, keeping state", map[string]any{"id": state.ID.ValueString()})
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI profiling task", fmt.Errorf("could not read SAP DI profiling task ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromTask(ctx, task)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update takes over changed timeouts and waits again for a task which was
+// still running, as every other change starts a new task.
+func (r *profilingTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan profilingTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state profilingTaskResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waiting := plan.Status.IsUnknown()
+
+	plan.Status = state.Status
+	plan.Messages = state.Messages
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !waiting {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultProfilingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.waitForTask(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes the task from the Terraform state, the profiling results are kept.
+func (r *profilingTaskResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ModifyPlan plans the status of a task which is still running as unknown, so
+// the apply waits for it again.
+func (r *profilingTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to wait for on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var status types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := sap_di.Task{Status: status.ValueString()}
+	if !task.Done() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	}
+}
+
+// waitForTask waits for the profiling task to finish and saves it to state.
+// Running out of time only warns: an error would taint the resource and
+// start a second profiling run next to the one still running.
+func (r *profilingTaskResource) waitForTask(ctx context.Context, m *profilingTaskResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	task, err := r.client.WaitForTask(ctx, m.ID.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"SAP DI Profiling Task Still Running",
+			fmt.Sprintf("Stopped waiting for profiling task %s, the next apply waits for it again: %s", m.ID.ValueString(), err),
+		)
+		return
+	}
+
+	diags.Append(m.fromTask(ctx, task)...)
+	diags.Append(state.Set(ctx, m)...)
+	if diags.HasError() {
+		return
+	}
+
+	if task.Status != sap_di.TaskStatusCompleted {
+		diags.AddError(
+			"SAP DI Profiling Task Failed",
+			fmt.Sprintf(
+				"Profiling dataset %s in connection %s finished with status %s: %s",
+				m.DatasetUri.ValueString(),
+				m.ConnectionId.ValueString(),
+				task.Status,
+				strings.Join(task.ErrorMessages(), "; "),
+			),
+		)
+	}
+}
+
+// fromTask maps a SAP DI task onto the resource model.
+func (m *profilingTaskResourceModel) fromTask(ctx context.Context, task *sap_di.Task) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(task.Id)
+	m.Status = types.StringValue(task.Status)
+	m.Messages, diags = types.ListValueFrom(ctx, types.StringType, task.ErrorMessages())
+
+	return diags
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProfilingTaskResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_profiling_task" "test" {
+					connection_id = "P40_XYZ"
+					dataset_uri   = "/XYZ/012/ABCD"
+					triggers = {
+						publication = "1"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_profiling_task.test", "id", "profiling-0001"),
+					resource.TestCheckResourceAttr("sapdi_profiling_task.test", "connection_id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("sapdi_profiling_task.test", "dataset_uri", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("sapdi_profiling_task.test", "status", "COMPLETED"),
+					// Verify only error messages are surfaced
+					resource.TestCheckResourceAttr("sapdi_profiling_task.test", "messages.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// defaultPublicationTimeout is the create and update timeout of publications without a timeouts block.
+const defaultPublicationTimeout = 30 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &publicationResource{}
+	_ resource.ResourceWithConfigure   = &publicationResource{}
+	_ resource.ResourceWithImportState = &publicationResource{}
+	_ resource.ResourceWithModifyPlan  = &publicationResource{}
+)
+
+// NewPublicationResource is a helper function to simplify the provider implementation.
+func NewPublicationResource() resource.Resource {
+	return &publicationResource{}
+}
+
+// publicationResource is the resource implementation.
+type publicationResource struct {
+	client *sap_di.Client
+}
+
+// publicationResourceModel maps the resource schema data.
+type publicationResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	ConnectionId      types.String   `tfsdk:"connection_id"`
+	Path              types.String   `tfsdk:"path"`
+	IncludeSubfolders types.Bool     `tfsdk:"include_subfolders"`
+	WithLineage       types.Bool     `tfsdk:"with_lineage"`
+	TargetFolder      types.String   `tfsdk:"target_folder"`
+	Status            types.String   `tfsdk:"status"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *publicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Publication resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Publication resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *publicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_publication"
+}
+
+// Schema defines the schema for the resource.
+func (r *publicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a publication of a folder or dataset into the Metadata Explorer catalog. " +
+			"Creating or updating the publication waits for the publication task to finish.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the publication.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the publication.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the publication.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Connection ID of the published folder or dataset, e.g. P40_XYZ.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the published folder or dataset, e.g. /XYZ/012 or /XYZ/012/ABCD.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_subfolders": schema.BoolAttribute{
+				Description: "Whether the datasets of subfolders are published as well. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"with_lineage": schema.BoolAttribute{
+				Description: "Whether the lineage of the published datasets is extracted. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"target_folder": schema.StringAttribute{
+				Description: "Catalog folder the datasets are published into.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the latest publication task, e.g. COMPLETED or FAILED. " +
+					"A publication which failed is published again on the next apply.",
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// Create creates the publication and waits for the publication task to finish.
+func (r *publicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan publicationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultPublicationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating SAP DI publication", map[string]any{
+		"connection_id": plan.ConnectionId.ValueString(),
+		"path":          plan.Path.ValueString(),
+	})
+
+	created, err := r.client.CreatePublication(ctx, plan.toPublication())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI publication", fmt.Errorf("could not create publication: %w", err))
+		return
+	}
+
+	// Save the publication right away, so it is not orphaned if publishing fails.
+	plan.fromPublication(created)
+	plan.Status = types.StringValue(sap_di.TaskStatusPending)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForPublication(ctx, &plan, created, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *publicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state publicationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	publication, err := r.client.GetPublication(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI publication not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI publication", fmt.Errorf("could not read SAP DI publication ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	state.fromPublication(publication)
+
+	if publication.TaskId != "" {
+		task, err := r.client.GetTask(ctx, publication.TaskId)
+		switch {
+		case sap_di.IsNotFound(err):
+			// The monitoring purges old tasks, keep the last known status.
+		case err != nil:
+			addClientError(&resp.Diagnostics, "Error Reading SAP DI publication", fmt.Errorf("could not read publication task %s: %w", publication.TaskId, err))
+			return
+		default:
+			state.Status = types.StringValue(task.Status)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the publication and waits for the publication task to finish.
+func (r *publicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan publicationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultPublicationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updated, err := r.client.UpdatePublication(ctx, plan.toPublication())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI publication", fmt.Errorf("could not update publication: %w", err))
+		return
+	}
+
+	// The saved status tells the next plan to publish again if this task fails.
+	plan.fromPublication(updated)
+	plan.Status = types.StringValue(sap_di.TaskStatusPending)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForPublication(ctx, &plan, updated, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the publication and removes the Terraform state on success.
+func (r *publicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state publicationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePublication(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI publication", fmt.Errorf("could not delete publication: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing publication by its ID.
+func (r *publicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan publishes again if the latest publication task failed, as the
+// state matches the configuration although the catalog was not updated.
+func (r *publicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to publish again on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var status types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch status.ValueString() {
+	case sap_di.TaskStatusFailed, sap_di.TaskStatusCancelled:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	}
+}
+
+// waitForPublication waits for the task started by publishing, saves its
+// status and reports an error unless it completed. A failed publication is
+// left tainted on create and published again by the next apply on update.
+func (r *publicationResource) waitForPublication(ctx context.Context, m *publicationResourceModel, publication *sap_di.Publication, state *tfsdk.State, diags *diag.Diagnostics) {
+	tflog.Info(ctx, "Waiting for SAP DI publication task", map[string]any{
+		"id":      publication.Id,
+		"task_id": publication.TaskId,
+	})
+
+	task, err := r.client.WaitForTask(ctx, publication.TaskId)
+	if err != nil {
+		addClientError(diags, "Error Waiting for SAP DI publication", fmt.Errorf("publication task %s did not finish: %w", publication.TaskId, err))
+		return
+	}
+
+	m.Status = types.StringValue(task.Status)
+	diags.Append(state.Set(ctx, m)...)
+	if diags.HasError() {
+		return
+	}
+
+	if task.Status != sap_di.TaskStatusCompleted {
+		diags.AddError(
+			"SAP DI Publication Failed",
+			fmt.Sprintf(
+				"Publishing %s in connection %s finished with status %s: %s",
+				publication.QualifiedName,
+				publication.ConnectionId,
+				task.Status,
+				strings.Join(task.ErrorMessages(), "; "),
+			),
+		)
+	}
+}
+
+// toPublication converts the resource model into a SAP DI publication.
+func (m publicationResourceModel) toPublication() sap_di.Publication {
+	return sap_di.Publication{
+		Id:                m.ID.ValueString(),
+		Name:              m.Name.ValueString(),
+		Description:       m.Description.ValueString(),
+		ConnectionId:      m.ConnectionId.ValueString(),
+		QualifiedName:     m.Path.ValueString(),
+		IncludeSubfolders: m.IncludeSubfolders.ValueBool(),
+		WithLineage:       m.WithLineage.ValueBool(),
+		TargetFolder:      m.TargetFolder.ValueString(),
+	}
+}
+
+// fromPublication maps a SAP DI publication onto the resource model.
+func (m *publicationResourceModel) fromPublication(publication *sap_di.Publication) {
+	m.ID = types.StringValue(publication.Id)
+	m.Name = types.StringValue(publication.Name)
+	m.Description = types.StringValue(publication.Description)
+	m.ConnectionId = types.StringValue(publication.ConnectionId)
+	m.Path = types.StringValue(publication.QualifiedName)
+	m.IncludeSubfolders = types.BoolValue(publication.IncludeSubfolders)
+	m.WithLineage = types.BoolValue(publication.WithLineage)
+	m.TargetFolder = types.StringValue(publication.TargetFolder)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPublicationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_publication" "test" {
+					name               = "P40 XYZ/012"
+					description        = "Tables of the XYZ/012 package"
+					connection_id      = "P40_XYZ"
+					path               = "/XYZ/012"
+					include_subfolders = true
+					target_folder      = "/P40"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_publication.test", "id", "PUB_0001"),
+					resource.TestCheckResourceAttr("sapdi_publication.test", "name", "P40 XYZ/012"),
+					resource.TestCheckResourceAttr("sapdi_publication.test", "connection_id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("sapdi_publication.test", "path", "/XYZ/012"),
+					resource.TestCheckResourceAttr("sapdi_publication.test", "include_subfolders", "true"),
+					resource.TestCheckResourceAttr("sapdi_publication.test", "with_lineage", "false"),
+					resource.TestCheckResourceAttr("sapdi_publication.test", "target_folder", "/P40"),
+					resource.TestCheckResourceAttr("sapdi_publication.test", "status", "COMPLETED"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_publication.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &publicationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &publicationsDataSource{}
+)
+
+// NewPublicationsDataSource is a helper function to simplify the provider implementation.
+func NewPublicationsDataSource() datasource.DataSource {
+	return &publicationsDataSource{}
+}
+
+// publicationsDataSource is the data source implementation.
+type publicationsDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *publicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Publications data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Publications data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *publicationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_publications"
+}
+
+// Schema defines the schema for the data source.
+func (d *publicationsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all catalog publications with their latest runs, optionally filtered by connection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"connection_id": schema.StringAttribute{
+				Description: "Only return publications of this connection, e.g. P40_XYZ.",
+				Optional:    true,
+			},
+			"publications": schema.ListNestedAttribute{
+				Description: "List of publications.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the publication.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the publication.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the publication.",
+							Computed:    true,
+						},
+						"connection_id": schema.StringAttribute{
+							Description: "Connection ID of the published folder or dataset.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "Path of the published folder or dataset.",
+							Computed:    true,
+						},
+						"include_subfolders": schema.BoolAttribute{
+							Description: "Whether the datasets of subfolders are published as well.",
+							Computed:    true,
+						},
+						"with_lineage": schema.BoolAttribute{
+							Description: "Whether the lineage of the published datasets is extracted.",
+							Computed:    true,
+						},
+						"target_folder": schema.StringAttribute{
+							Description: "Catalog folder the datasets are published into.",
+							Computed:    true,
+						},
+						"owner": schema.StringAttribute{
+							Description: "User owning the publication.",
+							Computed:    true,
+						},
+						"last_run_time": schema.StringAttribute{
+							Description: "Start time of the latest publication task.",
+							Computed:    true,
+						},
+						"last_status": schema.StringAttribute{
+							Description: "Status of the latest publication task, e.g. COMPLETED or FAILED.",
+							Computed:    true,
+						},
+						"runs": schema.ListNestedAttribute{
+							Description: "Status history of the publication tasks, latest first.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"task_id": schema.StringAttribute{
+										Description: "ID of the publication task.",
+										Computed:    true,
+									},
+									"status": schema.StringAttribute{
+										Description: "Status of the publication task.",
+										Computed:    true,
+									},
+									"start_time": schema.StringAttribute{
+										Description: "Start time of the publication task.",
+										Computed:    true,
+									},
+									"end_time": schema.StringAttribute{
+										Description: "End time of the publication task.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// publicationsDataSourceModel maps the data source schema data.
+type publicationsDataSourceModel struct {
+	ID           types.String        `tfsdk:"id"`
+	ConnectionId types.String        `tfsdk:"connection_id"`
+	Publications []publicationsModel `tfsdk:"publications"`
+	Timeouts     timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// publicationsModel maps publication schema data.
+type publicationsModel struct {
+	ID                types.String           `tfsdk:"id"`
+	Name              types.String           `tfsdk:"name"`
+	Description       types.String           `tfsdk:"description"`
+	ConnectionId      types.String           `tfsdk:"connection_id"`
+	Path              types.String           `tfsdk:"path"`
+	IncludeSubfolders types.Bool             `tfsdk:"include_subfolders"`
+	WithLineage       types.Bool             `tfsdk:"with_lineage"`
+	TargetFolder      types.String           `tfsdk:"target_folder"`
+	Owner             types.String           `tfsdk:"owner"`
+	LastRunTime       types.String           `tfsdk:"last_run_time"`
+	LastStatus        types.String           `tfsdk:"last_status"`
+	Runs              []publicationRunsModel `tfsdk:"runs"`
+}
+
+// publicationRunsModel maps publication run schema data.
+type publicationRunsModel struct {
+	TaskId    types.String `tfsdk:"task_id"`
+	Status    types.String `tfsdk:"status"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *publicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state publicationsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Publications data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	publications, err := d.client.ListPublications(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI publications", err)
+		return
+	}
+
+	// Map response body to model
+	state.Publications = []publicationsModel{}
+	for _, publication := range publications {
+		if !state.ConnectionId.IsNull() && publication.ConnectionId != state.ConnectionId.ValueString() {
+			continue
+		}
+
+		pub := publicationsModel{
+			ID:                types.StringValue(publication.Id),
+			Name:              types.StringValue(publication.Name),
+			Description:       types.StringValue(publication.Description),
+			ConnectionId:      types.StringValue(publication.ConnectionId),
+			Path:              types.StringValue(publication.QualifiedName),
+			IncludeSubfolders: types.BoolValue(publication.IncludeSubfolders),
+			WithLineage:       types.BoolValue(publication.WithLineage),
+			TargetFolder:      types.StringValue(publication.TargetFolder),
+			Owner:             types.StringValue(publication.Owner),
+			LastRunTime:       types.StringValue(publication.LastRunTime),
+			LastStatus:        types.StringValue(publication.LastStatus),
+			Runs:              []publicationRunsModel{},
+		}
+
+		for _, run := range publication.Runs {
+			pub.Runs = append(pub.Runs, publicationRunsModel{
+				TaskId:    types.StringValue(run.TaskId),
+				Status:    types.StringValue(run.Status),
+				StartTime: types.StringValue(run.StartTime),
+				EndTime:   types.StringValue(run.EndTime),
+			})
+		}
+
+		state.Publications = append(state.Publications, pub)
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
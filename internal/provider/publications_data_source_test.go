@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPublicationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_publications" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of publications returned
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.#", "2"),
+					// Verify the first publication to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.id", "PUB_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.connection_id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.path", "/XYZ/012"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.include_subfolders", "true"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.owner", "admin"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.last_run_time", "2023-11-20T07:58:41Z"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.last_status", "COMPLETED"),
+
+					// Verify the task status history
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.runs.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.runs.1.task_id", "publication-0000"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.runs.1.status", "FAILED"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "id", "placeholder"),
+				),
+			},
+			// Filter by connection
+			{
+				Config: providerConfig + `data "sapdi_publications" "test" {
+					connection_id = "HANA_PROD"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.#", "1"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.id", "PUB_0002"),
+					resource.TestCheckResourceAttr("data.sapdi_publications.test", "publications.0.last_status", "FAILED"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ruleCategoryResource{}
+	_ resource.ResourceWithConfigure   = &ruleCategoryResource{}
+	_ resource.ResourceWithImportState = &ruleCategoryResource{}
+)
+
+// NewRuleCategoryResource is a helper function to simplify the provider implementation.
+func NewRuleCategoryResource() resource.Resource {
+	return &ruleCategoryResource{}
+}
+
+// ruleCategoryResource is the resource implementation.
+type ruleCategoryResource struct {
+	client *sap_di.Client
+}
+
+// ruleCategoryResourceModel maps the resource schema data.
+type ruleCategoryResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ruleCategoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Rule Category resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Rule Category resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *ruleCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_category"
+}
+
+// Schema defines the schema for the resource.
+func (r *ruleCategoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a category of data quality rules in the Metadata Explorer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the rule category.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rule category.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rule category.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ruleCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleCategoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI rule category", map[string]any{"name": plan.Name.ValueString()})
+
+	created, err := r.client.CreateRuleCategory(ctx, plan.toRuleCategory())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI rule category", fmt.Errorf("could not create rule category: %w", err))
+		return
+	}
+
+	plan.fromRuleCategory(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ruleCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ruleCategoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.GetRuleCategory(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI rule category not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI rule category", fmt.Errorf("could not read SAP DI rule category ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	state.fromRuleCategory(category)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ruleCategoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateRuleCategory(ctx, plan.toRuleCategory())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI rule category", fmt.Errorf("could not update rule category: %w", err))
+		return
+	}
+
+	plan.fromRuleCategory(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ruleCategoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRuleCategory(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI rule category", fmt.Errorf("could not delete rule category: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing rule category by its ID.
+func (r *ruleCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toRuleCategory converts the resource model into a SAP DI rule category.
+func (m ruleCategoryResourceModel) toRuleCategory() sap_di.RuleCategory {
+	return sap_di.RuleCategory{
+		Id:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// fromRuleCategory maps a SAP DI rule category onto the resource model.
+func (m *ruleCategoryResourceModel) fromRuleCategory(category *sap_di.RuleCategory) {
+	m.ID = types.StringValue(category.Id)
+	m.Name = types.StringValue(category.Name)
+	m.Description = types.StringValue(category.Description)
+}
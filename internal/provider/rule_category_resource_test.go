@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleCategoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_rule_category" "test" {
+					name        = "Completeness"
+					description = "Checks for missing values"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_rule_category.test", "id", "RCAT_0001"),
+					resource.TestCheckResourceAttr("sapdi_rule_category.test", "name", "Completeness"),
+					resource.TestCheckResourceAttr("sapdi_rule_category.test", "description", "Checks for missing values"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_rule_category.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_rule_category" "test" {
+					name = "Completeness"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the removed description is cleared
+					resource.TestCheckResourceAttr("sapdi_rule_category.test", "description", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ruleResource{}
+	_ resource.ResourceWithConfigure   = &ruleResource{}
+	_ resource.ResourceWithImportState = &ruleResource{}
+)
+
+// NewRuleResource is a helper function to simplify the provider implementation.
+func NewRuleResource() resource.Resource {
+	return &ruleResource{}
+}
+
+// ruleResource is the resource implementation.
+type ruleResource struct {
+	client *sap_di.Client
+}
+
+// ruleResourceModel maps the resource schema data.
+type ruleResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	CategoryId  types.String         `tfsdk:"category_id"`
+	Name        types.String         `tfsdk:"name"`
+	Description types.String         `tfsdk:"description"`
+	Parameters  []ruleParameterModel `tfsdk:"parameters"`
+	Filters     []ruleConditionModel `tfsdk:"filters"`
+	Conditions  []ruleConditionModel `tfsdk:"conditions"`
+}
+
+// ruleParameterModel maps rule parameter schema data.
+type ruleParameterModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+// ruleConditionModel maps rule filter and condition schema data.
+type ruleConditionModel struct {
+	Name       types.String `tfsdk:"name"`
+	Expression types.String `tfsdk:"expression"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ruleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Rule resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Rule resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *ruleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *ruleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	conditionObj := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the condition.",
+				Required:    true,
+			},
+			"expression": schema.StringAttribute{
+				Description: "Expression over the rule parameters, e.g. $email MATCH '%@%'.",
+				Required:    true,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a data quality rule in the Metadata Explorer. Rules are applied to datasets by rulebooks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"category_id": schema.StringAttribute{
+				Description: "ID of the rule category containing the rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rule.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rule.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"parameters": schema.ListNestedAttribute{
+				Description: "Parameters of the rule, which rulebooks map to columns.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the parameter, referenced as $name in expressions.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the parameter, e.g. STRING, NUMBER or DATE.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the parameter.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"filters": schema.ListNestedAttribute{
+				Description:  "Filter conditions selecting the rows checked by the rule. All rows are checked if not set.",
+				Optional:     true,
+				NestedObject: conditionObj,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"conditions": schema.ListNestedAttribute{
+				Description:  "Conditions every checked row has to fulfill.",
+				Required:     true,
+				NestedObject: conditionObj,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI rule", map[string]any{
+		"category_id": plan.CategoryId.ValueString(),
+		"name":        plan.Name.ValueString(),
+	})
+
+	created, err := r.client.CreateRule(ctx, plan.toRule())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI rule", fmt.Errorf("could not create rule: %w", err))
+		return
+	}
+
+	plan.fromRule(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ruleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ruleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetRule(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI rule not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI rule", fmt.Errorf("could not read SAP DI rule ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	state.fromRule(rule)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ruleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateRule(ctx, plan.toRule())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI rule", fmt.Errorf("could not update rule: %w", err))
+		return
+	}
+
+	plan.fromRule(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ruleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRule(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI rule", fmt.Errorf("could not delete rule: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing rule by its ID.
+func (r *ruleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toRule converts the resource model into a SAP DI rule.
+func (m ruleResourceModel) toRule() sap_di.Rule {
+	rule := sap_di.Rule{
+		Id:          m.ID.ValueString(),
+		CategoryId:  m.CategoryId.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Parameters:  []sap_di.RuleParameter{},
+		Filters:     toRuleConditions(m.Filters),
+		Conditions:  toRuleConditions(m.Conditions),
+	}
+
+	for _, parameter := range m.Parameters {
+		rule.Parameters = append(rule.Parameters, sap_di.RuleParameter{
+			Name:        parameter.Name.ValueString(),
+			Type:        parameter.Type.ValueString(),
+			Description: parameter.Description.ValueString(),
+		})
+	}
+
+	return rule
+}
+
+// fromRule maps a SAP DI rule onto the resource model. Empty parameters and
+// filters are kept null, as they are optional in the schema.
+func (m *ruleResourceModel) fromRule(rule *sap_di.Rule) {
+	m.ID = types.StringValue(rule.Id)
+	m.CategoryId = types.StringValue(rule.CategoryId)
+	m.Name = types.StringValue(rule.Name)
+	m.Description = types.StringValue(rule.Description)
+
+	m.Parameters = nil
+	for _, parameter := range rule.Parameters {
+		p := ruleParameterModel{
+			Name:        types.StringValue(parameter.Name),
+			Type:        types.StringValue(parameter.Type),
+			Description: types.StringNull(),
+		}
+
+		if parameter.Description != "" {
+			p.Description = types.StringValue(parameter.Description)
+		}
+
+		m.Parameters = append(m.Parameters, p)
+	}
+
+	m.Filters = fromRuleConditions(rule.Filters)
+	m.Conditions = fromRuleConditions(rule.Conditions)
+}
+
+// toRuleConditions converts filter or condition models into SAP DI rule conditions.
+func toRuleConditions(models []ruleConditionModel) []sap_di.RuleCondition {
+	conditions := []sap_di.RuleCondition{}
+	for _, condition := range models {
+		conditions = append(conditions, sap_di.RuleCondition{
+			Name:       condition.Name.ValueString(),
+			Expression: condition.Expression.ValueString(),
+		})
+	}
+
+	return conditions
+}
+
+// fromRuleConditions maps SAP DI rule conditions to their models, nil if there are none.
+func fromRuleConditions(conditions []sap_di.RuleCondition) []ruleConditionModel {
+	var models []ruleConditionModel
+	for _, condition := range conditions {
+		models = append(models, ruleConditionModel{
+			Name:       types.StringValue(condition.Name),
+			Expression: types.StringValue(condition.Expression),
+		})
+	}
+
+	return models
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Empty conditions testing
+			{
+				Config: providerConfig + `resource "sapdi_rule" "test" {
+					category_id = "RCAT_0001"
+					name        = "Client is set"
+					conditions  = []
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_rule" "test" {
+					category_id = "RCAT_0001"
+					name        = "Client is set"
+					description = "Checks that the client of active records is set"
+
+					parameters = [
+						{
+							name        = "client"
+							type        = "STRING"
+							description = "Client of the record"
+						},
+						{
+							name = "status"
+							type = "STRING"
+						},
+					]
+
+					filters = [
+						{
+							name       = "Active records"
+							expression = "$status = 'A'"
+						},
+					]
+
+					conditions = [
+						{
+							name       = "Client not empty"
+							expression = "$client IS NOT NULL AND $client != ''"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_rule.test", "id", "RULE_0001"),
+					resource.TestCheckResourceAttr("sapdi_rule.test", "category_id", "RCAT_0001"),
+					resource.TestCheckResourceAttr("sapdi_rule.test", "parameters.#", "2"),
+					resource.TestCheckResourceAttr("sapdi_rule.test", "parameters.0.description", "Client of the record"),
+					resource.TestCheckNoResourceAttr("sapdi_rule.test", "parameters.1.description"),
+					resource.TestCheckResourceAttr("sapdi_rule.test", "filters.#", "1"),
+					resource.TestCheckResourceAttr("sapdi_rule.test", "filters.0.expression", "$status = 'A'"),
+					resource.TestCheckResourceAttr("sapdi_rule.test", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("sapdi_rule.test", "conditions.0.name", "Client not empty"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_rule" "test" {
+					category_id = "RCAT_0001"
+					name        = "Client is set"
+
+					parameters = [
+						{
+							name = "client"
+							type = "STRING"
+						},
+					]
+
+					conditions = [
+						{
+							name       = "Client not empty"
+							expression = "$client IS NOT NULL AND $client != ''"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_rule.test", "parameters.#", "1"),
+					resource.TestCheckNoResourceAttr("sapdi_rule.test", "parameters.0.description"),
+					resource.TestCheckNoResourceAttr("sapdi_rule.test", "filters.#"),
+
+					// Verify the removed description is cleared
+					resource.TestCheckResourceAttr("sapdi_rule.test", "description", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
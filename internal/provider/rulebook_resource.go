@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rulebookResource{}
+	_ resource.ResourceWithConfigure   = &rulebookResource{}
+	_ resource.ResourceWithImportState = &rulebookResource{}
+)
+
+// NewRulebookResource is a helper function to simplify the provider implementation.
+func NewRulebookResource() resource.Resource {
+	return &rulebookResource{}
+}
+
+// rulebookResource is the resource implementation.
+type rulebookResource struct {
+	client *sap_di.Client
+}
+
+// rulebookResourceModel maps the resource schema data.
+type rulebookResourceModel struct {
+	ID          types.String       `tfsdk:"id"`
+	Name        types.String       `tfsdk:"name"`
+	Description types.String       `tfsdk:"description"`
+	Bindings    []ruleBindingModel `tfsdk:"bindings"`
+}
+
+// ruleBindingModel maps rule binding schema data.
+type ruleBindingModel struct {
+	RuleId        types.String            `tfsdk:"rule_id"`
+	ConnectionId  types.String            `tfsdk:"connection_id"`
+	DatasetUri    types.String            `tfsdk:"dataset_uri"`
+	ColumnMapping map[string]types.String `tfsdk:"column_mapping"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rulebookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Rulebook resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Rulebook resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *rulebookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rulebook"
+}
+
+// Schema defines the schema for the resource.
+func (r *rulebookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a rulebook in the Metadata Explorer, which applies data quality rules to datasets.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the rulebook.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rulebook.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rulebook.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"bindings": schema.ListNestedAttribute{
+				Description: "Rules applied to datasets.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							Description: "ID of the rule.",
+							Required:    true,
+						},
+						"connection_id": schema.StringAttribute{
+							Description: "Connection ID of the dataset, e.g. P40_XYZ.",
+							Required:    true,
+						},
+						"dataset_uri": schema.StringAttribute{
+							Description: "URI of the dataset, e.g. /XYZ/012/ABCD.",
+							Required:    true,
+						},
+						"column_mapping": schema.MapAttribute{
+							Description: "Columns of the dataset by rule parameter name.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *rulebookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rulebookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI rulebook", map[string]any{"name": plan.Name.ValueString()})
+
+	created, err := r.client.CreateRulebook(ctx, plan.toRulebook())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI rulebook", fmt.Errorf("could not create rulebook: %w", err))
+		return
+	}
+
+	plan.fromRulebook(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *rulebookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rulebookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulebook, err := r.client.GetRulebook(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI rulebook not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI rulebook", fmt.Errorf("could not read SAP DI rulebook ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	state.fromRulebook(rulebook)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *rulebookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rulebookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateRulebook(ctx, plan.toRulebook())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI rulebook", fmt.Errorf("could not update rulebook: %w", err))
+		return
+	}
+
+	plan.fromRulebook(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *rulebookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rulebookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRulebook(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI rulebook", fmt.Errorf("could not delete rulebook: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing rulebook by its ID.
+func (r *rulebookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toRulebook converts the resource model into a SAP DI rulebook.
+func (m rulebookResourceModel) toRulebook() sap_di.Rulebook {
+	rulebook := sap_di.Rulebook{
+		Id:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Bindings:    []sap_di.RuleBinding{},
+	}
+
+	for _, binding := range m.Bindings {
+		b := sap_di.RuleBinding{
+			RuleId:        binding.RuleId.ValueString(),
+			ConnectionId:  binding.ConnectionId.ValueString(),
+			QualifiedName: binding.DatasetUri.ValueString(),
+			ColumnMapping: map[string]string{},
+		}
+
+		for parameter, column := range binding.ColumnMapping {
+			b.ColumnMapping[parameter] = column.ValueString()
+		}
+
+		rulebook.Bindings = append(rulebook.Bindings, b)
+	}
+
+	return rulebook
+}
+
+// fromRulebook maps a SAP DI rulebook onto the resource model. Without
+// bindings the attribute is kept null, as it is optional in the schema.
+func (m *rulebookResourceModel) fromRulebook(rulebook *sap_di.Rulebook) {
+	m.ID = types.StringValue(rulebook.Id)
+	m.Name = types.StringValue(rulebook.Name)
+	m.Description = types.StringValue(rulebook.Description)
+
+	m.Bindings = nil
+	for _, binding := range rulebook.Bindings {
+		b := ruleBindingModel{
+			RuleId:        types.StringValue(binding.RuleId),
+			ConnectionId:  types.StringValue(binding.ConnectionId),
+			DatasetUri:    types.StringValue(binding.QualifiedName),
+			ColumnMapping: map[string]types.String{},
+		}
+
+		for parameter, column := range binding.ColumnMapping {
+			b.ColumnMapping[parameter] = types.StringValue(column)
+		}
+
+		m.Bindings = append(m.Bindings, b)
+	}
+}
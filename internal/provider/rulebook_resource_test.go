@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRulebookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_rulebook" "test" {
+					name        = "Master Data Quality"
+					description = "Quality checks of the master data tables"
+
+					bindings = [
+						{
+							rule_id       = "RULE_0001"
+							connection_id = "P40_XYZ"
+							dataset_uri   = "/XYZ/012/ABCD"
+							column_mapping = {
+								client = "MANDT"
+								status = "ANZST"
+							}
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "id", "RB_0001"),
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "name", "Master Data Quality"),
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "bindings.#", "1"),
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "bindings.0.rule_id", "RULE_0001"),
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "bindings.0.dataset_uri", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "bindings.0.column_mapping.%", "2"),
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "bindings.0.column_mapping.client", "MANDT"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_rulebook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_rulebook" "test" {
+					name = "Master Data Quality"
+
+					bindings = [
+						{
+							rule_id       = "RULE_0001"
+							connection_id = "P40_XYZ"
+							dataset_uri   = "/XYZ/012/ABCD"
+							column_mapping = {
+								client = "MANDT"
+								status = "ANZST"
+							}
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "bindings.#", "1"),
+
+					// Verify the removed description is cleared
+					resource.TestCheckResourceAttr("sapdi_rulebook.test", "description", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rulebookResultsDataSource{}
+	_ datasource.DataSourceWithConfigure = &rulebookResultsDataSource{}
+)
+
+// NewRulebookResultsDataSource is a helper function to simplify the provider implementation.
+func NewRulebookResultsDataSource() datasource.DataSource {
+	return &rulebookResultsDataSource{}
+}
+
+// rulebookResultsDataSource is the data source implementation.
+type rulebookResultsDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rulebookResultsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Rulebook Results data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Rulebook Results data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *rulebookResultsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rulebook_results"
+}
+
+// Schema defines the schema for the data source.
+func (d *rulebookResultsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the results of the latest evaluation of a rulebook.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"rulebook_id": schema.StringAttribute{
+				Description: "ID of the rulebook.",
+				Required:    true,
+			},
+			"task_id": schema.StringAttribute{
+				Description: "ID of the task which evaluated the rulebook.",
+				Computed:    true,
+			},
+			"timestamp": schema.StringAttribute{
+				Description: "Time of the evaluation.",
+				Computed:    true,
+			},
+			"passed_rows": schema.Int64Attribute{
+				Description: "Number of passed rows, summed over all rules.",
+				Computed:    true,
+			},
+			"failed_rows": schema.Int64Attribute{
+				Description: "Number of failed rows, summed over all rules.",
+				Computed:    true,
+			},
+			"pass_rate": schema.Float64Attribute{
+				Description: "Percentage of passed rows over all rules from 0 to 100, null if no rows were checked.",
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Results by rule and dataset.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							Description: "ID of the rule.",
+							Computed:    true,
+						},
+						"rule_name": schema.StringAttribute{
+							Description: "Name of the rule.",
+							Computed:    true,
+						},
+						"connection_id": schema.StringAttribute{
+							Description: "Connection ID of the dataset.",
+							Computed:    true,
+						},
+						"dataset_uri": schema.StringAttribute{
+							Description: "URI of the dataset.",
+							Computed:    true,
+						},
+						"passed_rows": schema.Int64Attribute{
+							Description: "Number of rows which passed the rule.",
+							Computed:    true,
+						},
+						"failed_rows": schema.Int64Attribute{
+							Description: "Number of rows which failed the rule.",
+							Computed:    true,
+						},
+						"pass_rate": schema.Float64Attribute{
+							Description: "Percentage of passed rows from 0 to 100, null if no rows were checked.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// rulebookResultsDataSourceModel maps the data source schema data.
+type rulebookResultsDataSourceModel struct {
+	ID         types.String      `tfsdk:"id"`
+	RulebookId types.String      `tfsdk:"rulebook_id"`
+	TaskId     types.String      `tfsdk:"task_id"`
+	Timestamp  types.String      `tfsdk:"timestamp"`
+	PassedRows types.Int64       `tfsdk:"passed_rows"`
+	FailedRows types.Int64       `tfsdk:"failed_rows"`
+	PassRate   types.Float64     `tfsdk:"pass_rate"`
+	Rules      []ruleResultModel `tfsdk:"rules"`
+	Timeouts   timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// ruleResultModel maps rule result schema data.
+type ruleResultModel struct {
+	RuleId       types.String  `tfsdk:"rule_id"`
+	RuleName     types.String  `tfsdk:"rule_name"`
+	ConnectionId types.String  `tfsdk:"connection_id"`
+	DatasetUri   types.String  `tfsdk:"dataset_uri"`
+	PassedRows   types.Int64   `tfsdk:"passed_rows"`
+	FailedRows   types.Int64   `tfsdk:"failed_rows"`
+	PassRate     types.Float64 `tfsdk:"pass_rate"`
+}
+
+// ruleResultAttrTypes are the attribute types of ruleResultModel, for use in types.List values.
+var ruleResultAttrTypes = map[string]attr.Type{
+	"rule_id":       types.StringType,
+	"rule_name":     types.StringType,
+	"connection_id": types.StringType,
+	"dataset_uri":   types.StringType,
+	"passed_rows":   types.Int64Type,
+	"failed_rows":   types.Int64Type,
+	"pass_rate":     types.Float64Type,
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rulebookResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state rulebookResultsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Rulebook Results data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	results, err := d.client.GetRulebookResults(ctx, state.RulebookId.ValueString())
+	if sap_di.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rulebook_id"),
+			"SAP DI Rulebook Results Not Found",
+			fmt.Sprintf(
+				"No results found for rulebook %s. Ensure the rulebook exists and has been evaluated, e.g. by a sapdi_rulebook_run resource.\n\n"+
+					"SAP DI Client Error: %s",
+				state.RulebookId.ValueString(),
+				err.Error(),
+			),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI rulebook results", err)
+		return
+	}
+
+	// Map response body to model
+	state.TaskId = types.StringValue(results.TaskId)
+	state.Timestamp = types.StringValue(results.Timestamp)
+	state.Rules = ruleResults(results.Rules)
+
+	passed, failed := ruleResultTotals(results.Rules)
+	state.PassedRows = types.Int64Value(passed)
+	state.FailedRows = types.Int64Value(failed)
+	state.PassRate = passRate(passed, failed)
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ruleResults maps SAP DI rule results to their model.
+func ruleResults(results []sap_di.RuleResult) []ruleResultModel {
+	models := []ruleResultModel{}
+	for _, result := range results {
+		models = append(models, ruleResultModel{
+			RuleId:       types.StringValue(result.RuleId),
+			RuleName:     types.StringValue(result.RuleName),
+			ConnectionId: types.StringValue(result.ConnectionId),
+			DatasetUri:   types.StringValue(result.QualifiedName),
+			PassedRows:   types.Int64Value(result.PassedRows),
+			FailedRows:   types.Int64Value(result.FailedRows),
+			PassRate:     passRate(result.PassedRows, result.FailedRows),
+		})
+	}
+
+	return models
+}
+
+// ruleResultTotals sums the passed and failed rows over all rule results.
+func ruleResultTotals(results []sap_di.RuleResult) (int64, int64) {
+	var passed, failed int64
+	for _, result := range results {
+		passed += result.PassedRows
+		failed += result.FailedRows
+	}
+
+	return passed, failed
+}
+
+// passRate returns the percentage of passed rows, null if no rows were checked.
+func passRate(passed int64, failed int64) types.Float64 {
+	if passed+failed == 0 {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(float64(passed) * 100 / float64(passed+failed))
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRulebookResultsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_rulebook_results" "test" {
+					rulebook_id = "RB_0001"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "task_id", "rulebook-run-0001"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "timestamp", "2023-11-20T09:01:47Z"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "passed_rows", "1188"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "failed_rows", "62"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "pass_rate", "95.04"),
+
+					// Verify number of rule results returned
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "rules.#", "2"),
+					// Verify the first rule result to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "rules.0.rule_id", "RULE_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "rules.0.rule_name", "Client is set"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "rules.0.connection_id", "P40_XYZ"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "rules.0.dataset_uri", "/XYZ/012/ABCD"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "rules.0.passed_rows", "1188"),
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "rules.0.pass_rate", "95.04"),
+					// Verify rules without checked rows have no pass rate
+					resource.TestCheckNoResourceAttr("data.sapdi_rulebook_results.test", "rules.1.pass_rate"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_rulebook_results.test", "id", "placeholder"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,428 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// defaultRulebookRunTimeout is the create timeout of rulebook runs without a timeouts block.
+const defaultRulebookRunTimeout = 30 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &rulebookRunResource{}
+	_ resource.ResourceWithConfigure  = &rulebookRunResource{}
+	_ resource.ResourceWithModifyPlan = &rulebookRunResource{}
+)
+
+// NewRulebookRunResource is a helper function to simplify the provider implementation.
+func NewRulebookRunResource() resource.Resource {
+	return &rulebookRunResource{}
+}
+
+// rulebookRunResource is the resource implementation.
+type rulebookRunResource struct {
+	client *sap_di.Client
+}
+
+// rulebookRunResourceModel maps the resource schema data.
+type rulebookRunResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	RulebookId types.String   `tfsdk:"rulebook_id"`
+	Triggers   types.Map      `tfsdk:"triggers"`
+	Status     types.String   `tfsdk:"status"`
+	Messages   types.List     `tfsdk:"messages"`
+	PassedRows types.Int64    `tfsdk:"passed_rows"`
+	FailedRows types.Int64    `tfsdk:"failed_rows"`
+	PassRate   types.Float64  `tfsdk:"pass_rate"`
+	Rules      types.List     `tfsdk:"rules"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rulebookRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Rulebook Run resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Rulebook Run resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *rulebookRunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rulebook_run"
+}
+
+// Schema defines the schema for the resource.
+func (r *rulebookRunResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates the rules of a rulebook and waits for the evaluation to finish. " +
+			"If the evaluation is still running when the timeout is reached, the next apply waits for it again. " +
+			"Destroying the resource keeps the results.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the evaluation task.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rulebook_id": schema.StringAttribute{
+				Description: "ID of the rulebook.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which start a new evaluation when changed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the evaluation task, e.g. COMPLETED or FAILED.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"messages": schema.ListAttribute{
+				Description: "Error messages reported by the evaluation task.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"passed_rows": schema.Int64Attribute{
+				Description: "Number of passed rows, summed over all rules.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"failed_rows": schema.Int64Attribute{
+				Description: "Number of failed rows, summed over all rules.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"pass_rate": schema.Float64Attribute{
+				Description: "Percentage of passed rows over all rules from 0 to 100, null if no rows were checked.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Results by rule and dataset.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							Description: "ID of the rule.",
+							Computed:    true,
+						},
+						"rule_name": schema.StringAttribute{
+							Description: "Name of the rule.",
+							Computed:    true,
+						},
+						"connection_id": schema.StringAttribute{
+							Description: "Connection ID of the dataset.",
+							Computed:    true,
+						},
+						"dataset_uri": schema.StringAttribute{
+							Description: "URI of the dataset.",
+							Computed:    true,
+						},
+						"passed_rows": schema.Int64Attribute{
+							Description: "Number of rows which passed the rule.",
+							Computed:    true,
+						},
+						"failed_rows": schema.Int64Attribute{
+							Description: "Number of rows which failed the rule.",
+							Computed:    true,
+						},
+						"pass_rate": schema.Float64Attribute{
+							Description: "Percentage of passed rows from 0 to 100, null if no rows were checked.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// Create starts the evaluation and waits for it to finish.
+func (r *rulebookRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rulebookRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultRulebookRunTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Starting SAP DI rulebook run", map[string]any{"rulebook_id": plan.RulebookId.ValueString()})
+
+	task, err := r.client.RunRulebook(ctx, plan.RulebookId.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Starting SAP DI rulebook run", fmt.Errorf("could not run rulebook: %w", err))
+		return
+	}
+
+	// Results are only known once the task completed.
+	resp.Diagnostics.Append(plan.fromResults(ctx, nil)...)
+
+	// Record the run before waiting, so a later apply can wait for it again.
+	resp.Diagnostics.Append(plan.fromTask(ctx, task)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForRun(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data. The results are
+// kept, as later runs of the rulebook replace them.
+func (r *rulebookRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rulebookRunResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetTask(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		// The monitoring purges old tasks, which must not run the rulebook again.
+		tflog.Debug(ctx, "SAP DI rulebook run no longer in monitoring, keeping state", map[string]any{"id": state.ID.ValueString()})
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI rulebook run", fmt.Errorf("could not read SAP DI rulebook run ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromTask(ctx, task)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update takes over changed timeouts and waits again for a run which was
+// still running, as every other change starts a new run.
+func (r *rulebookRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rulebookRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state rulebookRunResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waiting := plan.Status.IsUnknown()
+
+	plan.Status = state.Status
+	plan.Messages = state.Messages
+	plan.PassedRows = state.PassedRows
+	plan.FailedRows = state.FailedRows
+	plan.PassRate = state.PassRate
+	plan.Rules = state.Rules
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !waiting {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultRulebookRunTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.waitForRun(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes the run from the Terraform state, the results are kept.
+func (r *rulebookRunResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ModifyPlan plans the status and results of a run which is still running as
+// unknown, so the apply waits for it again.
+func (r *rulebookRunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to wait for on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var status types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := sap_di.Task{Status: status.ValueString()}
+	if task.Done() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("passed_rows"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("failed_rows"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pass_rate"), types.Float64Unknown())...)
+}
+
+// waitForRun waits for the evaluation to finish and saves it to state along
+// with its results. Running out of time only warns, since a tainted run
+// would start another evaluation while this one is still in progress.
+func (r *rulebookRunResource) waitForRun(ctx context.Context, m *rulebookRunResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	task, err := r.client.WaitForTask(ctx, m.ID.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"SAP DI Rulebook Run Still Running",
+			fmt.Sprintf("Stopped waiting for rulebook run %s, the next apply waits for it again: %s", m.ID.ValueString(), err),
+		)
+		return
+	}
+
+	diags.Append(m.fromTask(ctx, task)...)
+	diags.Append(state.Set(ctx, m)...)
+	if diags.HasError() {
+		return
+	}
+
+	if task.Status != sap_di.TaskStatusCompleted {
+		diags.AddError(
+			"SAP DI Rulebook Run Failed",
+			fmt.Sprintf(
+				"Evaluating rulebook %s finished with status %s: %s",
+				m.RulebookId.ValueString(),
+				task.Status,
+				strings.Join(task.ErrorMessages(), "; "),
+			),
+		)
+		return
+	}
+
+	results, err := r.client.GetRulebookResults(ctx, m.RulebookId.ValueString())
+	if err != nil {
+		addClientError(diags, "Error Reading SAP DI rulebook results", fmt.Errorf("could not read results of rulebook run %s: %w", m.ID.ValueString(), err))
+		return
+	}
+
+	// The rulebook only keeps the results of its latest run, which are not
+	// ours if another run finished in the meantime.
+	if results.TaskId != task.Id {
+		diags.AddError(
+			"SAP DI Rulebook Results Replaced",
+			fmt.Sprintf(
+				"The results of rulebook %s belong to run %s instead of run %s, another run finished in the meantime. "+
+					"Run the rulebook again to record its results.",
+				m.RulebookId.ValueString(),
+				results.TaskId,
+				task.Id,
+			),
+		)
+		return
+	}
+
+	diags.Append(m.fromResults(ctx, results)...)
+	diags.Append(state.Set(ctx, m)...)
+}
+
+// fromTask maps a SAP DI task onto the resource model.
+func (m *rulebookRunResourceModel) fromTask(ctx context.Context, task *sap_di.Task) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(task.Id)
+	m.Status = types.StringValue(task.Status)
+	m.Messages, diags = types.ListValueFrom(ctx, types.StringType, task.ErrorMessages())
+
+	return diags
+}
+
+// fromResults maps SAP DI rulebook results onto the resource model, nil
+// results leave the counts null and the rules empty.
+func (m *rulebookRunResourceModel) fromResults(ctx context.Context, results *sap_di.RulebookResults) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.PassedRows = types.Int64Null()
+	m.FailedRows = types.Int64Null()
+	m.PassRate = types.Float64Null()
+
+	rules := []ruleResultModel{}
+	if results != nil {
+		passed, failed := ruleResultTotals(results.Rules)
+		m.PassedRows = types.Int64Value(passed)
+		m.FailedRows = types.Int64Value(failed)
+		m.PassRate = passRate(passed, failed)
+		rules = ruleResults(results.Rules)
+	}
+
+	m.Rules, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleResultAttrTypes}, rules)
+
+	return diags
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRulebookRunResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_rulebook_run" "test" {
+					rulebook_id = "RB_0001"
+					triggers = {
+						deployment = "1"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "id", "rulebook-run-0001"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "rulebook_id", "RB_0001"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "status", "COMPLETED"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "messages.#", "0"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "passed_rows", "1188"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "failed_rows", "62"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "pass_rate", "95.04"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "rules.#", "2"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "rules.0.rule_id", "RULE_0001"),
+					resource.TestCheckResourceAttr("sapdi_rulebook_run.test", "rules.0.failed_rows", "62"),
+				),
+			},
+			// Results of another run testing, the results of RB_0002 belong to a run
+			// which finished while waiting for this one
+			{
+				Config: providerConfig + `resource "sapdi_rulebook_run" "test" {
+					rulebook_id = "RB_0002"
+				}`,
+				ExpectError: regexp.MustCompile(`belong\s+to\s+run\s+rulebook-run-0003\s+instead\s+of\s+run\s+rulebook-run-0002`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
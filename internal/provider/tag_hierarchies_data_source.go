@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// tagPathSeparator joins the names of a tag and its parents.
+const tagPathSeparator = " > "
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tagHierarchiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &tagHierarchiesDataSource{}
+)
+
+// NewTagHierarchiesDataSource is a helper function to simplify the provider implementation.
+func NewTagHierarchiesDataSource() datasource.DataSource {
+	return &tagHierarchiesDataSource{}
+}
+
+// tagHierarchiesDataSource is the data source implementation.
+type tagHierarchiesDataSource struct {
+	client *sap_di.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tagHierarchiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Tag Hierarchies data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Tag Hierarchies data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *tagHierarchiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_hierarchies"
+}
+
+// Schema defines the schema for the data source.
+func (d *tagHierarchiesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all tag hierarchies of the Metadata Explorer including their tags.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"hierarchies": schema.ListNestedAttribute{
+				Description: "List of tag hierarchies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the tag hierarchy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the tag hierarchy.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the tag hierarchy.",
+							Computed:    true,
+						},
+						"tags": schema.ListNestedAttribute{
+							Description: "Tags of the hierarchy.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "ID of the tag.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "Name of the tag.",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "Description of the tag.",
+										Computed:    true,
+									},
+									"parent_id": schema.StringAttribute{
+										Description: "ID of the parent tag, null for tags at the top of the hierarchy.",
+										Computed:    true,
+									},
+									"path": schema.StringAttribute{
+										Description: "Names of the parent tags and the tag, joined by \"" + tagPathSeparator + "\", e.g. PII > Email.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// tagHierarchiesDataSourceModel maps the data source schema data.
+type tagHierarchiesDataSourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	Hierarchies []tagHierarchiesModel `tfsdk:"hierarchies"`
+	Timeouts    timeouts.Value        `tfsdk:"timeouts"`
+}
+
+// tagHierarchiesModel maps tag hierarchy schema data.
+type tagHierarchiesModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        []tagsModel  `tfsdk:"tags"`
+}
+
+// tagsModel maps tag schema data.
+type tagsModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ParentId    types.String `tfsdk:"parent_id"`
+	Path        types.String `tfsdk:"path"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tagHierarchiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tagHierarchiesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading SAP DI Tag Hierarchies data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	hierarchies, err := d.client.ListTagHierarchies(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to Read SAP DI tag hierarchies", err)
+		return
+	}
+
+	// Map response body to model
+	state.Hierarchies = []tagHierarchiesModel{}
+	for _, hierarchy := range hierarchies {
+		h := tagHierarchiesModel{
+			ID:          types.StringValue(hierarchy.Id),
+			Name:        types.StringValue(hierarchy.Name),
+			Description: types.StringValue(hierarchy.Description),
+			Tags:        []tagsModel{},
+		}
+
+		tagsById := map[string]sap_di.Tag{}
+		for _, tag := range hierarchy.Tags {
+			tagsById[tag.Id] = tag
+		}
+
+		for _, tag := range hierarchy.Tags {
+			t := tagsModel{
+				ID:          types.StringValue(tag.Id),
+				Name:        types.StringValue(tag.Name),
+				Description: types.StringValue(tag.Description),
+				ParentId:    types.StringNull(),
+				Path:        types.StringValue(tagPath(tag, tagsById)),
+			}
+
+			if tag.ParentId != "" {
+				t.ParentId = types.StringValue(tag.ParentId)
+			}
+
+			h.Tags = append(h.Tags, t)
+		}
+
+		state.Hierarchies = append(state.Hierarchies, h)
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// tagPath joins the names from the top of the hierarchy down to the tag.
+// Unknown parents end the path, so does a parent visited twice.
+func tagPath(tag sap_di.Tag, tagsById map[string]sap_di.Tag) string {
+	names := []string{tag.Name}
+	visited := map[string]bool{tag.Id: true}
+
+	for parentId := tag.ParentId; parentId != "" && !visited[parentId]; {
+		parent, ok := tagsById[parentId]
+		if !ok {
+			break
+		}
+
+		names = append([]string{parent.Name}, names...)
+		visited[parentId] = true
+		parentId = parent.ParentId
+	}
+
+	return strings.Join(names, tagPathSeparator)
+}
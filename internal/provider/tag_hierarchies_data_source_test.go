@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagHierarchiesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "sapdi_tag_hierarchies" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of hierarchies returned
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.#", "2"),
+					// Verify the first hierarchy to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.id", "HIER_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.name", "Data Classification"),
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.tags.#", "2"),
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.tags.0.name", "PII"),
+					resource.TestCheckNoResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.tags.0.parent_id"),
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.tags.0.path", "PII"),
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.tags.1.parent_id", "TAG_0001"),
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.0.tags.1.path", "PII > Email"),
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "hierarchies.1.tags.#", "0"),
+
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttr("data.sapdi_tag_hierarchies.test", "id", "placeholder"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tagHierarchyResource{}
+	_ resource.ResourceWithConfigure   = &tagHierarchyResource{}
+	_ resource.ResourceWithImportState = &tagHierarchyResource{}
+)
+
+// NewTagHierarchyResource is a helper function to simplify the provider implementation.
+func NewTagHierarchyResource() resource.Resource {
+	return &tagHierarchyResource{}
+}
+
+// tagHierarchyResource is the resource implementation.
+type tagHierarchyResource struct {
+	client *sap_di.Client
+}
+
+// tagHierarchyResourceModel maps the resource schema data.
+type tagHierarchyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *tagHierarchyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Tag Hierarchy resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Tag Hierarchy resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *tagHierarchyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_hierarchy"
+}
+
+// Schema defines the schema for the resource.
+func (r *tagHierarchyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a tag hierarchy in the Metadata Explorer, e.g. Data Classification.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the tag hierarchy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the tag hierarchy.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the tag hierarchy.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *tagHierarchyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tagHierarchyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI tag hierarchy", map[string]any{"name": plan.Name.ValueString()})
+
+	created, err := r.client.CreateTagHierarchy(ctx, plan.toTagHierarchy())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI tag hierarchy", fmt.Errorf("could not create tag hierarchy: %w", err))
+		return
+	}
+
+	plan.fromTagHierarchy(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *tagHierarchyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tagHierarchyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hierarchy, err := r.client.GetTagHierarchy(ctx, state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI tag hierarchy not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI tag hierarchy", fmt.Errorf("could not read SAP DI tag hierarchy ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	state.fromTagHierarchy(hierarchy)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *tagHierarchyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tagHierarchyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateTagHierarchy(ctx, plan.toTagHierarchy())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI tag hierarchy", fmt.Errorf("could not update tag hierarchy: %w", err))
+		return
+	}
+
+	plan.fromTagHierarchy(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *tagHierarchyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tagHierarchyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTagHierarchy(ctx, state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI tag hierarchy", fmt.Errorf("could not delete tag hierarchy: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing tag hierarchy by its ID.
+func (r *tagHierarchyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toTagHierarchy converts the resource model into a SAP DI tag hierarchy.
+func (m tagHierarchyResourceModel) toTagHierarchy() sap_di.TagHierarchy {
+	return sap_di.TagHierarchy{
+		Id:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// fromTagHierarchy maps a SAP DI tag hierarchy onto the resource model.
+func (m *tagHierarchyResourceModel) fromTagHierarchy(hierarchy *sap_di.TagHierarchy) {
+	m.ID = types.StringValue(hierarchy.Id)
+	m.Name = types.StringValue(hierarchy.Name)
+	m.Description = types.StringValue(hierarchy.Description)
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagHierarchyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_tag_hierarchy" "test" {
+					name        = "Data Classification"
+					description = "Classification of datasets by sensitivity"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_tag_hierarchy.test", "id", "HIER_0001"),
+					resource.TestCheckResourceAttr("sapdi_tag_hierarchy.test", "name", "Data Classification"),
+					resource.TestCheckResourceAttr("sapdi_tag_hierarchy.test", "description", "Classification of datasets by sensitivity"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_tag_hierarchy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
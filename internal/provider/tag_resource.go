@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/mondata-dev/terraform-provider-sap-di/internal/sap_di"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tagResource{}
+	_ resource.ResourceWithConfigure   = &tagResource{}
+	_ resource.ResourceWithImportState = &tagResource{}
+)
+
+// NewTagResource is a helper function to simplify the provider implementation.
+func NewTagResource() resource.Resource {
+	return &tagResource{}
+}
+
+// tagResource is the resource implementation.
+type tagResource struct {
+	client *sap_di.Client
+}
+
+// tagResourceModel maps the resource schema data.
+type tagResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	HierarchyId types.String `tfsdk:"hierarchy_id"`
+	ParentId    types.String `tfsdk:"parent_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *tagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring SAP DI Tag resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sap_di.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sap_di.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured SAP DI Tag resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *tagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+// Schema defines the schema for the resource.
+func (r *tagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a tag of a tag hierarchy in the Metadata Explorer, e.g. PII below Data Classification.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the tag.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hierarchy_id": schema.StringAttribute{
+				Description: "ID of the tag hierarchy containing the tag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "ID of the parent tag. The tag is placed at the top of the hierarchy if not set.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the tag.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the tag.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tagResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating SAP DI tag", map[string]any{
+		"hierarchy_id": plan.HierarchyId.ValueString(),
+		"name":         plan.Name.ValueString(),
+	})
+
+	created, err := r.client.CreateTag(ctx, plan.toTag())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Creating SAP DI tag", fmt.Errorf("could not create tag: %w", err))
+		return
+	}
+
+	plan.fromTag(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tagResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := r.client.GetTag(ctx, state.HierarchyId.ValueString(), state.ID.ValueString())
+	if sap_di.IsNotFound(err) {
+		tflog.Warn(ctx, "SAP DI tag not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading SAP DI tag", fmt.Errorf("could not read SAP DI tag ID %s: %w", state.ID.ValueString(), err))
+		return
+	}
+
+	state.fromTag(tag)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tagResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateTag(ctx, plan.toTag())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Updating SAP DI tag", fmt.Errorf("could not update tag: %w", err))
+		return
+	}
+
+	plan.fromTag(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tagResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTag(ctx, state.HierarchyId.ValueString(), state.ID.ValueString())
+	if err != nil && !sap_di.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error Deleting SAP DI tag", fmt.Errorf("could not delete tag: %w", err))
+		return
+	}
+}
+
+// ImportState imports an existing tag by its hierarchy ID and tag ID, separated by a comma.
+func (r *tagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: hierarchy_id,tag_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hierarchy_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// toTag converts the resource model into a SAP DI tag.
+func (m tagResourceModel) toTag() sap_di.Tag {
+	return sap_di.Tag{
+		Id:          m.ID.ValueString(),
+		HierarchyId: m.HierarchyId.ValueString(),
+		ParentId:    m.ParentId.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// fromTag maps a SAP DI tag onto the resource model. Tags at the top of the
+// hierarchy keep a null parent_id.
+func (m *tagResourceModel) fromTag(tag *sap_di.Tag) {
+	m.ID = types.StringValue(tag.Id)
+	m.HierarchyId = types.StringValue(tag.HierarchyId)
+	m.Name = types.StringValue(tag.Name)
+	m.Description = types.StringValue(tag.Description)
+
+	m.ParentId = types.StringNull()
+	if tag.ParentId != "" {
+		m.ParentId = types.StringValue(tag.ParentId)
+	}
+}
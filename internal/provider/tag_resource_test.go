@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_tag" "test" {
+					hierarchy_id = "HIER_0001"
+					parent_id    = "TAG_0001"
+					name         = "Email"
+					description  = "Email addresses"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_tag.test", "id", "TAG_0002"),
+					resource.TestCheckResourceAttr("sapdi_tag.test", "hierarchy_id", "HIER_0001"),
+					resource.TestCheckResourceAttr("sapdi_tag.test", "parent_id", "TAG_0001"),
+					resource.TestCheckResourceAttr("sapdi_tag.test", "name", "Email"),
+					resource.TestCheckResourceAttr("sapdi_tag.test", "description", "Email addresses"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sapdi_tag.test",
+				ImportState:       true,
+				ImportStateId:     "HIER_0001,TAG_0002",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `resource "sapdi_tag" "test" {
+					hierarchy_id = "HIER_0001"
+					name         = "Email"
+					description  = "Email addresses"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sapdi_tag.test", "id", "TAG_0002"),
+
+					// Verify the tag is moved to the top of the hierarchy
+					resource.TestCheckNoResourceAttr("sapdi_tag.test", "parent_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
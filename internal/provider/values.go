@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// valueString renders a loosely typed JSON value as a string. Nested values
+// are rendered as JSON.
+func valueString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
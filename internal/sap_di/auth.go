@@ -0,0 +1,139 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// AuthMethodBasic sends username and password with every request.
+	AuthMethodBasic = "basic"
+	// AuthMethodOAuth2ClientCredentials fetches an access token from TokenURL
+	// using the OAuth2 client credentials grant.
+	AuthMethodOAuth2ClientCredentials = "oauth2_client_credentials"
+	// AuthMethodBearerToken sends a static bearer token with every request.
+	AuthMethodBearerToken = "bearer_token"
+)
+
+// tokenExpiryLeeway is the time before expiry at which a cached access token
+// is refreshed, so it does not run out while a request is in flight.
+const tokenExpiryLeeway = 60 * time.Second
+
+// defaultTokenLifetime is assumed for access tokens without a positive
+// expires_in, which would otherwise be refreshed before every request.
+const defaultTokenLifetime = 300 * time.Second
+
+// accessToken is a cached OAuth2 access token.
+type accessToken struct {
+	value  string
+	expiry time.Time
+}
+
+// tokenResponse maps the token endpoint response.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// authorize sets the Authorization header according to the auth method.
+func (c *Client) authorize(req *http.Request) error {
+	switch c.Auth.Method {
+	case AuthMethodOAuth2ClientCredentials:
+		token, err := c.accessToken(req.Context())
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	case AuthMethodBearerToken:
+		req.Header.Set("Authorization", "Bearer "+c.Auth.Token)
+	default:
+		req.Header.Set("Authorization", "Basic "+basicAuth(c.username(), c.Auth.Password))
+	}
+
+	return nil
+}
+
+// username returns the login name, qualified with the tenant if one is set.
+func (c *Client) username() string {
+	if c.Auth.Tenant == "" {
+		return c.Auth.Username
+	}
+
+	return c.Auth.Tenant + "\\" + c.Auth.Username
+}
+
+// accessToken returns the cached access token, fetching a new one if there is
+// none yet or it is about to expire.
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != nil && time.Now().Add(tokenExpiryLeeway).Before(c.token.expiry) {
+		return c.token.value, nil
+	}
+
+	token, err := c.fetchAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	c.token = token
+
+	return token.value, nil
+}
+
+// fetchAccessToken requests a new access token using the client credentials grant.
+func (c *Client) fetchAccessToken(ctx context.Context) (*accessToken, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.Auth.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.Auth.ClientID), url.QueryEscape(c.Auth.ClientSecret))
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(req, res, body)
+	}
+
+	token := tokenResponse{}
+	err = json.Unmarshal(body, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("fetching access token failed, no access_token in response")
+	}
+
+	lifetime := time.Duration(token.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+
+	return &accessToken{
+		value:  token.AccessToken,
+		expiry: time.Now().Add(lifetime),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package sap_di
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuthorizeOAuth2ClientCredentials(t *testing.T) {
+	tokenRequests := 0
+	expiresIn := 3600
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			tokenRequests++
+
+			clientID, clientSecret, ok := r.BasicAuth()
+			if !ok || clientID != "client" || clientSecret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer","expires_in":%d}`, tokenRequests, expiresIn)
+		default:
+			fmt.Fprint(w, r.Header.Get("Authorization"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{
+		Method:       AuthMethodOAuth2ClientCredentials,
+		TokenURL:     server.URL + "/oauth/token",
+		ClientID:     "client",
+		ClientSecret: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func() string {
+		req, err := http.NewRequest("GET", server.URL+"/api", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := client.doRequest(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	// The token is fetched once and then served from the cache.
+	if got := get(); got != "Bearer token-1" {
+		t.Errorf("expected Bearer token-1, got %q", got)
+	}
+	if got := get(); got != "Bearer token-1" {
+		t.Errorf("expected cached Bearer token-1, got %q", got)
+	}
+	if tokenRequests != 1 {
+		t.Errorf("expected 1 token request, got %d", tokenRequests)
+	}
+
+	// Tokens about to expire are refreshed before use.
+	expiresIn = 10
+	client.token = nil
+	get()
+	if got := get(); got != "Bearer token-3" {
+		t.Errorf("expected refreshed Bearer token-3, got %q", got)
+	}
+}
+
+func TestAuthorizeOAuth2ClientCredentialsWithoutExpiry(t *testing.T) {
+	tokenRequests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			tokenRequests++
+
+			// The first token has no expires_in, the second one expires_in 0.
+			if tokenRequests == 1 {
+				fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer"}`, tokenRequests)
+			} else {
+				fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer","expires_in":0}`, tokenRequests)
+			}
+		default:
+			fmt.Fprint(w, r.Header.Get("Authorization"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{
+		Method:       AuthMethodOAuth2ClientCredentials,
+		TokenURL:     server.URL + "/oauth/token",
+		ClientID:     "client",
+		ClientSecret: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func() string {
+		req, err := http.NewRequest("GET", server.URL+"/api", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := client.doRequest(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	// Tokens without a lifetime are cached for the default lifetime.
+	for _, want := range []string{"Bearer token-1", "Bearer token-1"} {
+		if got := get(); got != want {
+			t.Errorf("expected %s, got %q", want, got)
+		}
+	}
+
+	client.token = nil
+	for _, want := range []string{"Bearer token-2", "Bearer token-2"} {
+		if got := get(); got != want {
+			t.Errorf("expected %s, got %q", want, got)
+		}
+	}
+
+	if tokenRequests != 2 {
+		t.Errorf("expected 2 token requests, got %d", tokenRequests)
+	}
+}
+
+func TestAuthorizeBearerToken(t *testing.T) {
+	host := "http://localhost"
+	client, err := NewClient(&host, AuthStruct{Method: AuthMethodBearerToken, Token: "static"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest("GET", host, nil)
+	if err := client.authorize(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := req.Header.Get("Authorization"); got != "Bearer static" {
+		t.Errorf("expected Bearer static, got %q", got)
+	}
+}
+
+func TestAuthorizeBasic(t *testing.T) {
+	host := "http://localhost"
+	client, err := NewClient(&host, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest("GET", host, nil)
+	if err := client.authorize(req); err != nil {
+		t.Fatal(err)
+	}
+
+	username, password, ok := req.BasicAuth()
+	if !ok || username != "admin" || password != "test123" {
+		t.Errorf("expected basic auth admin:test123, got %q:%q", username, password)
+	}
+}
+
+func TestAuthorizeBasicTenant(t *testing.T) {
+	host := "http://localhost"
+	client, err := NewClient(&host, AuthStruct{Tenant: "default", Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest("GET", host, nil)
+	if err := client.authorize(req); err != nil {
+		t.Fatal(err)
+	}
+
+	username, _, ok := req.BasicAuth()
+	if !ok || username != `default\admin` {
+		t.Errorf(`expected basic auth user default\admin, got %q`, username)
+	}
+}
+
+func TestNewClientInvalidAuthMethod(t *testing.T) {
+	host := "http://localhost"
+	if _, err := NewClient(&host, AuthStruct{Method: "kerberos"}); err == nil {
+		t.Error("expected error for unsupported auth method")
+	}
+}
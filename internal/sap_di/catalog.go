@@ -0,0 +1,43 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// BrowseCatalog - Returns the direct children of a folder of a connection.
+func (c *Client) BrowseCatalog(ctx context.Context, connection string, folder string) ([]CatalogObject, error) {
+	// replace forward slashes with %2F
+	folder = strings.Replace(folder, "/", "%2F", -1)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf(
+			"%s/app/datahub-app-metadata/api/v1/catalog/connections/%s/containers/%s/children",
+			c.HostURL,
+			connection,
+			folder,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []CatalogObject{}
+	err = json.Unmarshal(body, &objects)
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
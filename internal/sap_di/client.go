@@ -5,35 +5,71 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/cookiejar"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Auth       AuthStruct
+
+	tokenMu sync.Mutex
+	token   *accessToken
+
+	Retry RetryStruct
+
+	// PollInterval is the wait between status checks of asynchronous tasks.
+	PollInterval time.Duration
+
+	csrfMu    sync.Mutex
+	csrfToken string
+	// csrfFetched is set once a token was fetched, which is empty if DI
+	// does not require one.
+	csrfFetched bool
 }
 
 type AuthStruct struct {
+	// Method is one of the AuthMethod constants, defaults to AuthMethodBasic.
+	Method string `json:"method"`
+
+	// Tenant is prepended to the username as tenant\user for basic auth.
+	Tenant   string `json:"tenant"`
 	Username string `json:"username"`
 	Password string `json:"password"`
+
+	TokenURL     string `json:"token_url"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+
+	Token string `json:"token"`
 }
 
-func NewClient(host, username, password *string) (*Client, error) {
-	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		// Default Hashicups URL
-		HostURL: *host,
+func NewClient(host *string, auth AuthStruct) (*Client, error) {
+	// DI issues session cookies which have to be sent along with the CSRF token.
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
 	}
 
-	// If username or password not provided, return empty client
-	if username == nil || password == nil {
-		return &c, nil
+	c := Client{
+		HTTPClient: &http.Client{Timeout: 10 * time.Second, Jar: jar},
+		HostURL:    *host,
+		Auth:       auth,
+		Retry:      DefaultRetry,
+
+		PollInterval: 5 * time.Second,
 	}
 
-	c.Auth = AuthStruct{
-		Username: *username,
-		Password: *password,
+	switch c.Auth.Method {
+	case "":
+		c.Auth.Method = AuthMethodBasic
+	case AuthMethodBasic, AuthMethodOAuth2ClientCredentials, AuthMethodBearerToken:
+	default:
+		return nil, fmt.Errorf("unsupported auth method %q", c.Auth.Method)
 	}
 
 	return &c, nil
@@ -45,24 +81,133 @@ func basicAuth(username, password string) string {
 }
 
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	ctx := req.Context()
+
+	var res *http.Response
+	var body []byte
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		tflog.Debug(ctx, "Sending SAP DI request", map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt,
+		})
+
+		res, body, err = c.attempt(req)
+
+		if attempt > c.Retry.MaxRetries || !retryable(ctx, req, res, err) {
+			break
+		}
+
+		wait := c.Retry.backoff(attempt, res)
+
+		tflog.Warn(ctx, "Retrying SAP DI request", map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt,
+			"status":  statusOf(res),
+			"error":   fmt.Sprint(err),
+			"wait":    wait.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		req, err = rewindRequest(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, newAPIError(req, res, body)
+	}
+
+	return body, nil
+}
+
+// attempt sends the request once, renewing the CSRF token if it was rejected.
+func (c *Client) attempt(req *http.Request) (*http.Response, []byte, error) {
+	res, body, err := c.send(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The CSRF token is bound to the session and is rejected once the session
+	// expired, so fetch a new one and try once more.
+	if csrfRequired(req, res) {
+		c.resetCSRFToken()
+
+		req, err = rewindRequest(req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return c.send(req)
+	}
+
+	return res, body, nil
+}
+
+// send authorizes and sends a single request and reads the response body.
+func (c *Client) send(req *http.Request) (*http.Response, []byte, error) {
 	// Note: this will have problems if there are redirects
 	// see https://stackoverflow.com/a/31309385
-	req.Header.Set("Authorization", "Basic "+basicAuth(c.Auth.Username, c.Auth.Password))
+	err := c.authorize(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if csrfProtected(req.Method) {
+		token, err := c.csrfTokenFor(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		if token != "" {
+			req.Header.Set(csrfTokenHeader, token)
+		}
+	}
 
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	return res, body, nil
+}
+
+// rewindRequest returns a copy of req with a fresh body so it can be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+
+	// The cookie jar adds the session cookies to the sent request, drop them
+	// so the current ones are used.
+	retry.Header.Del("Cookie")
+
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("cannot resend %s %s, request body is not rewindable", req.Method, req.URL)
+		}
+
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
 	}
 
-	return body, err
+	return retry, nil
 }
@@ -0,0 +1,130 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ListConnections - Returns all connections.
+func (c *Client) ListConnections(ctx context.Context) ([]Connection, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-connection/connections", c.HostURL),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	connections := []Connection{}
+	err = json.Unmarshal(body, &connections)
+	if err != nil {
+		return nil, err
+	}
+
+	return connections, nil
+}
+
+// GetConnection - Returns a specific connection.
+func (c *Client) GetConnection(ctx context.Context, id string) (*Connection, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-connection/connections/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := &Connection{}
+	err = json.Unmarshal(body, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return connection, nil
+}
+
+// CreateConnection - Creates a new connection.
+func (c *Client) CreateConnection(ctx context.Context, connection Connection) (*Connection, error) {
+	rb, err := json.Marshal(connection)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-connection/connections", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The create endpoint does not return the full connection, so read it back.
+	return c.GetConnection(ctx, connection.Id)
+}
+
+// UpdateConnection - Updates an existing connection.
+func (c *Client) UpdateConnection(ctx context.Context, connection Connection) (*Connection, error) {
+	rb, err := json.Marshal(connection)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-connection/connections/%s", c.HostURL, connection.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetConnection(ctx, connection.Id)
+}
+
+// DeleteConnection - Deletes a connection.
+func (c *Client) DeleteConnection(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-connection/connections/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
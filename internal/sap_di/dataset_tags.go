@@ -0,0 +1,74 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetDatasetTags - Returns the tags assigned to a dataset and its columns.
+func (c *Client) GetDatasetTags(ctx context.Context, connection string, dataset string) (*DatasetTags, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		datasetTagsURL(c.HostURL, connection, dataset),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := &DatasetTags{}
+	err = json.Unmarshal(body, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// SetDatasetTags - Replaces all tags of a dataset and its columns.
+func (c *Client) SetDatasetTags(ctx context.Context, connection string, dataset string, tags DatasetTags) (*DatasetTags, error) {
+	rb, err := json.Marshal(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		datasetTagsURL(c.HostURL, connection, dataset),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetDatasetTags(ctx, connection, dataset)
+}
+
+// datasetTagsURL returns the URL of the tags of a dataset.
+func datasetTagsURL(host string, connection string, dataset string) string {
+	// replace forward slashes with %2F
+	dataset = strings.Replace(dataset, "/", "%2F", -1)
+
+	return fmt.Sprintf(
+		"%s/app/datahub-app-metadata/api/v1/catalog/connections/%s/datasets/%s/tags",
+		host,
+		connection,
+		dataset,
+	)
+}
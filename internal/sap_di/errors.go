@@ -0,0 +1,170 @@
+package sap_di
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when the requested object does not exist in SAP DI.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is returned when SAP DI rejects the credentials or denies access.
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// APIError is returned for every non-successful response of the SAP DI API.
+type APIError struct {
+	StatusCode int
+	// Code is the SAP error code, if DI returned one.
+	Code      string
+	Message   string
+	RequestID string
+	Method    string
+	URL       string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: status %d", e.Method, e.URL, e.StatusCode)
+	if e.Code != "" {
+		msg += fmt.Sprintf(", code %s", e.Code)
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+
+	return msg
+}
+
+// Is lets errors.Is match an APIError against ErrNotFound and ErrUnauthorized.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	default:
+		return false
+	}
+}
+
+// IsNotFound reports whether err signals that the requested object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err signals rejected credentials or denied access.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// apiErrorBody maps the error bodies of the DI services. Depending on the
+// service, code and message are sent at the top level, nested in error, or
+// as OAuth2 error and error_description.
+type apiErrorBody struct {
+	Code             json.RawMessage `json:"code"`
+	Message          string          `json:"message"`
+	Error            json.RawMessage `json:"error"`
+	ErrorDescription string          `json:"error_description"`
+}
+
+// apiErrorDetail maps an error nested in the error body.
+type apiErrorDetail struct {
+	Code    json.RawMessage `json:"code"`
+	Message json.RawMessage `json:"message"`
+}
+
+// newAPIError builds an APIError from a non-successful response.
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		RequestID:  firstHeader(res.Header, "X-Request-Id", "X-Correlation-Id", "X-Vcap-Request-Id"),
+	}
+
+	parsed := apiErrorBody{}
+	if json.Unmarshal(body, &parsed) == nil {
+		apiErr.Code = rawString(parsed.Code)
+		apiErr.Message = parsed.Message
+
+		detail := apiErrorDetail{}
+		if code := rawString(parsed.Error); code != "" {
+			detail.Code = parsed.Error
+		} else if len(parsed.Error) > 0 {
+			_ = json.Unmarshal(parsed.Error, &detail)
+		}
+
+		if apiErr.Code == "" {
+			apiErr.Code = rawString(detail.Code)
+		}
+		if apiErr.Message == "" {
+			apiErr.Message = errorMessage(detail.Message)
+		}
+		if apiErr.Message == "" {
+			apiErr.Message = parsed.ErrorDescription
+		}
+	}
+
+	// Fall back to the raw body, e.g. for HTML error pages of the ingress.
+	if apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(res.StatusCode)
+		}
+	}
+
+	return apiErr
+}
+
+// rawString returns a JSON string or number as string.
+func rawString(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+
+	var n json.Number
+	if json.Unmarshal(raw, &n) == nil {
+		return n.String()
+	}
+
+	return ""
+}
+
+// errorMessage returns a message given either as string or as OData style
+// object with a value.
+func errorMessage(raw json.RawMessage) string {
+	if s := rawString(raw); s != "" {
+		return s
+	}
+
+	var m struct {
+		Value string `json:"value"`
+	}
+	if json.Unmarshal(raw, &m) == nil {
+		return m.Value
+	}
+
+	return ""
+}
+
+// firstHeader returns the first non-empty value of the given headers.
+func firstHeader(header http.Header, keys ...string) string {
+	for _, key := range keys {
+		if value := header.Get(key); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
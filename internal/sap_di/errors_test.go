@@ -0,0 +1,83 @@
+package sap_di
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRequestAPIError(t *testing.T) {
+	cases := map[string]struct {
+		status       int
+		body         string
+		code         string
+		message      string
+		notFound     bool
+		unauthorized bool
+	}{
+		"top level": {
+			status:   http.StatusNotFound,
+			body:     `{"code":"CATALOG_DATASET_NOT_FOUND","message":"Dataset not found"}`,
+			code:     "CATALOG_DATASET_NOT_FOUND",
+			message:  "Dataset not found",
+			notFound: true,
+		},
+		"nested": {
+			status:  http.StatusBadRequest,
+			body:    `{"error":{"code":"400","message":{"lang":"en","value":"Invalid connection type"}}}`,
+			code:    "400",
+			message: "Invalid connection type",
+		},
+		"oauth2": {
+			status:       http.StatusUnauthorized,
+			body:         `{"error":"invalid_client","error_description":"Bad credentials"}`,
+			code:         "invalid_client",
+			message:      "Bad credentials",
+			unauthorized: true,
+		},
+		"plain": {
+			status:  http.StatusBadGateway,
+			body:    "<html>502 Bad Gateway</html>",
+			message: "<html>502 Bad Gateway</html>",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-1")
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			client.Retry.MaxRetries = 0
+
+			req, _ := http.NewRequest("GET", server.URL+"/api", nil)
+			_, err = client.doRequest(req)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != tc.status || apiErr.Code != tc.code || apiErr.Message != tc.message {
+				t.Errorf("unexpected APIError %+v", apiErr)
+			}
+			if apiErr.RequestID != "req-1" || apiErr.URL != server.URL+"/api" {
+				t.Errorf("expected request ID and URL to be set, got %+v", apiErr)
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("expected IsNotFound to be %t", tc.notFound)
+			}
+			if IsUnauthorized(err) != tc.unauthorized {
+				t.Errorf("expected IsUnauthorized to be %t", tc.unauthorized)
+			}
+		})
+	}
+}
@@ -1,18 +1,21 @@
 package sap_di
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 )
 
-// GetFactsheet - Returns a specific factsheet.
-func (c *Client) GetFactsheet(connection string, dataset string) (*Factsheet, error) {
+// GetFactsheet - Returns a specific factsheet, including the profiling
+// statistics if includeProfile is set.
+func (c *Client) GetFactsheet(ctx context.Context, connection string, dataset string, includeProfile bool) (*Factsheet, error) {
 	// replace forward slashes with %2F
 	dataset = strings.Replace(dataset, "/", "%2F", -1)
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/app/datahub-app-metadata/api/v1/catalog/connections/%s/datasets/%s/factsheet",
@@ -26,6 +29,12 @@ func (c *Client) GetFactsheet(connection string, dataset string) (*Factsheet, er
 		return nil, err
 	}
 
+	if includeProfile {
+		query := req.URL.Query()
+		query.Set("includeProfile", "true")
+		req.URL.RawQuery = query.Encode()
+	}
+
 	body, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,235 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetGlossary - Returns a specific glossary.
+func (c *Client) GetGlossary(ctx context.Context, id string) (*Glossary, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/glossaries/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	glossary := &Glossary{}
+	err = json.Unmarshal(body, glossary)
+	if err != nil {
+		return nil, err
+	}
+
+	return glossary, nil
+}
+
+// CreateGlossary - Creates a new glossary.
+func (c *Client) CreateGlossary(ctx context.Context, glossary Glossary) (*Glossary, error) {
+	rb, err := json.Marshal(glossary)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/glossaries", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &Glossary{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetGlossary(ctx, created.Id)
+}
+
+// UpdateGlossary - Updates an existing glossary.
+func (c *Client) UpdateGlossary(ctx context.Context, glossary Glossary) (*Glossary, error) {
+	rb, err := json.Marshal(glossary)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/glossaries/%s", c.HostURL, glossary.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetGlossary(ctx, glossary.Id)
+}
+
+// DeleteGlossary - Deletes a glossary.
+func (c *Client) DeleteGlossary(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/glossaries/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// ListGlossaryTerms - Returns the terms of all glossaries.
+func (c *Client) ListGlossaryTerms(ctx context.Context) ([]GlossaryTerm, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms", c.HostURL),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []GlossaryTerm{}
+	err = json.Unmarshal(body, &terms)
+	if err != nil {
+		return nil, err
+	}
+
+	return terms, nil
+}
+
+// GetGlossaryTerm - Returns a specific glossary term.
+func (c *Client) GetGlossaryTerm(ctx context.Context, id string) (*GlossaryTerm, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	term := &GlossaryTerm{}
+	err = json.Unmarshal(body, term)
+	if err != nil {
+		return nil, err
+	}
+
+	return term, nil
+}
+
+// CreateGlossaryTerm - Creates a new term in a glossary.
+func (c *Client) CreateGlossaryTerm(ctx context.Context, term GlossaryTerm) (*GlossaryTerm, error) {
+	rb, err := json.Marshal(term)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &GlossaryTerm{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetGlossaryTerm(ctx, created.Id)
+}
+
+// UpdateGlossaryTerm - Updates an existing glossary term.
+func (c *Client) UpdateGlossaryTerm(ctx context.Context, term GlossaryTerm) (*GlossaryTerm, error) {
+	rb, err := json.Marshal(term)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms/%s", c.HostURL, term.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetGlossaryTerm(ctx, term.Id)
+}
+
+// DeleteGlossaryTerm - Deletes a glossary term.
+func (c *Client) DeleteGlossaryTerm(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,83 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ListGlossaryTermAssignments - Returns the datasets and columns a glossary term is assigned to.
+func (c *Client) ListGlossaryTermAssignments(ctx context.Context, termId string) ([]GlossaryTermAssignment, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms/%s/assignments", c.HostURL, termId),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := []GlossaryTermAssignment{}
+	err = json.Unmarshal(body, &assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// CreateGlossaryTermAssignment - Assigns a glossary term to a dataset or column.
+func (c *Client) CreateGlossaryTermAssignment(ctx context.Context, assignment GlossaryTermAssignment) (*GlossaryTermAssignment, error) {
+	rb, err := json.Marshal(assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms/%s/assignments", c.HostURL, assignment.TermId),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &GlossaryTermAssignment{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// DeleteGlossaryTermAssignment - Removes a glossary term from a dataset or column.
+func (c *Client) DeleteGlossaryTermAssignment(ctx context.Context, termId string, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/glossary/terms/%s/assignments/%s", c.HostURL, termId, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,128 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	GraphStatusPending   = "pending"
+	GraphStatusRunning   = "running"
+	GraphStatusStopping  = "stopping"
+	GraphStatusCompleted = "completed"
+	GraphStatusDead      = "dead"
+)
+
+// Done reports whether the graph execution reached a final status.
+func (e *GraphExecution) Done() bool {
+	switch e.Status {
+	case GraphStatusCompleted, GraphStatusDead:
+		return true
+	default:
+		return false
+	}
+}
+
+// StartGraph - Starts a graph of the vflow repository with the given
+// configuration substitutions and returns the execution.
+func (c *Client) StartGraph(ctx context.Context, name string, substitutions map[string]string) (*GraphExecution, error) {
+	rb, err := json.Marshal(graphStart{
+		Src:                        name,
+		Name:                       name,
+		ConfigurationSubstitutions: substitutions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/pipeline-modeler/service/v1/runtime/graphs", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := &GraphExecution{}
+	err = json.Unmarshal(body, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	return execution, nil
+}
+
+// GetGraphExecution - Returns a specific graph execution.
+func (c *Client) GetGraphExecution(ctx context.Context, handle string) (*GraphExecution, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/pipeline-modeler/service/v1/runtime/graphs/%s", c.HostURL, handle),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := &GraphExecution{}
+	err = json.Unmarshal(body, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	return execution, nil
+}
+
+// StopGraphExecution - Requests a graph execution to stop.
+func (c *Client) StopGraphExecution(ctx context.Context, handle string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/pipeline-modeler/service/v1/runtime/graphs/%s/stop", c.HostURL, handle),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// WaitForGraphExecution - Polls a graph execution until it reached status or
+// a final status, or ctx is done.
+func (c *Client) WaitForGraphExecution(ctx context.Context, handle string, status string) (*GraphExecution, error) {
+	for {
+		execution, err := c.GetGraphExecution(ctx, handle)
+		if err != nil {
+			return nil, err
+		}
+
+		if execution.Status == status || execution.Done() {
+			return execution, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return execution, fmt.Errorf("waiting for graph execution %s with status %s: %w", handle, execution.Status, ctx.Err())
+		case <-time.After(c.PollInterval):
+		}
+	}
+}
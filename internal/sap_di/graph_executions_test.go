@@ -0,0 +1,84 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGraphExecutionLifecycle(t *testing.T) {
+	polls := 0
+	stopped := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/app/pipeline-modeler/service/v1/runtime/graphs":
+			start := graphStart{}
+			json.NewDecoder(r.Body).Decode(&start)
+			if start.Src != "com.mycompany.ingest.orders" || start.ConfigurationSubstitutions["TARGET"] != "/orders" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"handle":"h1","src":"com.mycompany.ingest.orders","status":"pending"}`)
+
+		case r.Method == "PUT" && r.URL.Path == "/app/pipeline-modeler/service/v1/runtime/graphs/h1/stop":
+			stopped = true
+
+		case r.Method == "GET" && r.URL.Path == "/app/pipeline-modeler/service/v1/runtime/graphs/h1":
+			polls++
+
+			status := GraphStatusPending
+			switch {
+			case stopped:
+				status = GraphStatusDead
+			case polls >= 3:
+				status = GraphStatusRunning
+			}
+			fmt.Fprintf(w, `{"handle":"h1","status":%q}`, status)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.PollInterval = time.Millisecond
+
+	execution, err := client.StartGraph(context.Background(), "com.mycompany.ingest.orders", map[string]string{"TARGET": "/orders"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if execution.Handle != "h1" {
+		t.Errorf("expected handle h1, got %s", execution.Handle)
+	}
+
+	// Waiting for running stops polling once the graph runs.
+	execution, err = client.WaitForGraphExecution(context.Background(), "h1", GraphStatusRunning)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if execution.Status != GraphStatusRunning || polls != 3 {
+		t.Errorf("expected status %s after 3 polls, got %s after %d", GraphStatusRunning, execution.Status, polls)
+	}
+
+	// Waiting for completed stops at the final status of stopped graphs.
+	err = client.StopGraphExecution(context.Background(), "h1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	execution, err = client.WaitForGraphExecution(context.Background(), "h1", GraphStatusCompleted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !execution.Done() || execution.Status != GraphStatusDead {
+		t.Errorf("expected final status %s, got %s", GraphStatusDead, execution.Status)
+	}
+}
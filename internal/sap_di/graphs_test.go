@@ -0,0 +1,63 @@
+package sap_di
+
+import (
+	"testing"
+)
+
+func TestNormalizeGraph(t *testing.T) {
+	graph := `{
+		"description": "Ingest orders",
+		"processes": {
+			"reader": {
+				"component": "com.sap.storage.read",
+				"metadata": {"label": "Read File", "x": 12, "y": 40, "width": 120, "height": 80, "config": {"path": "/orders"}}
+			}
+		},
+		"groups": [{"name": "group1", "nodes": ["reader"], "metadata": {"x": 0, "y": 0}}],
+		"connections": [{"metadata": {"points": "132,52 200,52"}, "src": {"port": "file", "process": "reader"}, "tgt": {"port": "in", "process": "writer"}}],
+		"properties": {"autoRecovery": 1.50}
+	}`
+
+	moved := `{"properties":{"autoRecovery":1.50},
+		"connections": [{"src": {"process": "reader", "port": "file"}, "tgt": {"process": "writer", "port": "in"}, "metadata": {"points": "10,10 20,20"}}],
+		"groups": [{"nodes": ["reader"], "name": "group1", "metadata": {"x": 300, "y": 300}}],
+		"processes": {"reader": {"metadata": {"config": {"path": "/orders"}, "label": "Read File", "x": 500, "y": 7}, "component": "com.sap.storage.read"}},
+		"description": "Ingest orders"}`
+
+	normalized, err := NormalizeGraph([]byte(graph))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Key order, whitespace and layout do not matter, numbers are kept as written.
+	expected := `{"connections":[{"metadata":{},"src":{"port":"file","process":"reader"},"tgt":{"port":"in","process":"writer"}}],` +
+		`"description":"Ingest orders","groups":[{"metadata":{},"name":"group1","nodes":["reader"]}],` +
+		`"processes":{"reader":{"component":"com.sap.storage.read","metadata":{"config":{"path":"/orders"},"label":"Read File"}}},` +
+		`"properties":{"autoRecovery":1.50}}`
+	if normalized != expected {
+		t.Errorf("expected %s, got %s", expected, normalized)
+	}
+
+	normalizedMoved, err := NormalizeGraph([]byte(moved))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if normalizedMoved != normalized {
+		t.Errorf("expected moved graph to normalize to %s, got %s", normalized, normalizedMoved)
+	}
+
+	// Changes to the graph itself are kept.
+	changed, err := NormalizeGraph([]byte(`{"description": "Ingest orders", "processes": {"reader": {"component": "com.sap.storage.write"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed == normalized {
+		t.Error("expected changed graph to normalize differently")
+	}
+
+	for _, invalid := range []string{`[]`, `{"processes": {}`, `{} {}`, ``} {
+		if _, err := NormalizeGraph([]byte(invalid)); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
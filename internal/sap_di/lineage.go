@@ -0,0 +1,58 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	LineageDirectionUpstream   = "UPSTREAM"
+	LineageDirectionDownstream = "DOWNSTREAM"
+	LineageDirectionBoth       = "BOTH"
+)
+
+// GetLineage - Returns the lineage graph of a dataset in the given direction.
+// A depth of 0 returns the full lineage.
+func (c *Client) GetLineage(ctx context.Context, connection string, dataset string, direction string, depth int) (*Lineage, error) {
+	// replace forward slashes with %2F
+	dataset = strings.Replace(dataset, "/", "%2F", -1)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf(
+			"%s/app/datahub-app-metadata/api/v1/catalog/connections/%s/datasets/%s/lineage",
+			c.HostURL,
+			connection,
+			dataset,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	query.Set("direction", direction)
+	if depth > 0 {
+		query.Set("depth", strconv.Itoa(depth))
+	}
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	lineage := &Lineage{}
+	err = json.Unmarshal(body, lineage)
+	if err != nil {
+		return nil, err
+	}
+
+	return lineage, nil
+}
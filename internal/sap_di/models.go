@@ -3,19 +3,40 @@ package sap_di
 type Factsheet struct {
 	Metadata FactsheetMetadata `json:"metadata"`
 	Columns  []FactsheetColumn `json:"columns"`
+	Version  string            `json:"version"`
 }
 
 type FactsheetMetadata struct {
-	Name         string                 `json:"name"`
-	Uri          string                 `json:"uri"`
-	ConnectionId string                 `json:"connectionId"`
-	Descriptions []FactsheetDescription `json:"descriptions"`
+	Name           string                 `json:"name"`
+	Uri            string                 `json:"uri"`
+	Type           string                 `json:"type"`
+	ConnectionId   string                 `json:"connectionId"`
+	ConnectionType string                 `json:"connectionType"`
+	ColCount       int64                  `json:"colCount"`
+	RowCount       int64                  `json:"rowCount"`
+	SampleRowCount int64                  `json:"sampleRowCount"`
+	Sampled        bool                   `json:"sampled"`
+	Profile        *FactsheetProfile      `json:"profile"`
+	UniqueKeys     []FactsheetUniqueKey   `json:"uniqueKeys"`
+	Properties     []FactsheetProperty    `json:"properties"`
+	Descriptions   []FactsheetDescription `json:"descriptions"`
+	Terms          []FactsheetTerm        `json:"terms"`
+	Tags           []FactsheetTag         `json:"tags"`
 }
 
 type FactsheetColumn struct {
-	Name         string                 `json:"name"`
-	Type         string                 `json:"type"`
-	Descriptions []FactsheetDescription `json:"descriptions"`
+	Name         string                  `json:"name"`
+	Type         string                  `json:"type"`
+	TemplateType string                  `json:"templateType"`
+	Length       *int64                  `json:"length"`
+	Precision    *int64                  `json:"precision"`
+	Scale        *int64                  `json:"scale"`
+	UniqueGroups string                  `json:"uniqueGroups"`
+	Properties   []FactsheetProperty     `json:"properties"`
+	Descriptions []FactsheetDescription  `json:"descriptions"`
+	Profile      *FactsheetColumnProfile `json:"profile"`
+	Terms        []FactsheetTerm         `json:"terms"`
+	Tags         []FactsheetTag          `json:"tags"`
 }
 
 type FactsheetDescription struct {
@@ -23,3 +44,287 @@ type FactsheetDescription struct {
 	Type   string `json:"type"`
 	Value  string `json:"value"`
 }
+
+// FactsheetTerm is a glossary term assigned to the dataset or a column.
+type FactsheetTerm struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	GlossaryId string `json:"glossaryId"`
+}
+
+// FactsheetTag is a tag assigned to the dataset or a column.
+type FactsheetTag struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	HierarchyId string `json:"hierarchyId"`
+}
+
+type FactsheetUniqueKey struct {
+	AttributeReferences []string `json:"attributeReferences"`
+}
+
+// FactsheetProfile holds the dataset statistics of the last profiling run.
+type FactsheetProfile struct {
+	RowCount       int64  `json:"rowCount"`
+	SampleRowCount int64  `json:"sampleRowCount"`
+	Timestamp      string `json:"timestamp"`
+}
+
+// FactsheetColumnProfile holds the column statistics of the last profiling run.
+type FactsheetColumnProfile struct {
+	NullCount     *int64              `json:"nullCount"`
+	DistinctCount *int64              `json:"distinctCount"`
+	Min           any                 `json:"min"`
+	Max           any                 `json:"max"`
+	TopValues     []FactsheetTopValue `json:"topValues"`
+}
+
+type FactsheetTopValue struct {
+	Value any   `json:"value"`
+	Count int64 `json:"count"`
+}
+
+type FactsheetProperty struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+type Connection struct {
+	Id          string         `json:"id"`
+	Type        string         `json:"type"`
+	Description string         `json:"description"`
+	Tags        []string       `json:"tags"`
+	ContentData map[string]any `json:"contentData,omitempty"`
+}
+
+type Task struct {
+	Id        string        `json:"id"`
+	Type      string        `json:"type"`
+	Status    string        `json:"status"`
+	StartTime string        `json:"startTime"`
+	EndTime   string        `json:"endTime"`
+	Messages  []TaskMessage `json:"messages"`
+}
+
+type TaskMessage struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// startedTask maps the response of endpoints starting an asynchronous task.
+type startedTask struct {
+	TaskId string `json:"taskId"`
+}
+
+type Publication struct {
+	Id                string `json:"id,omitempty"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	ConnectionId      string `json:"connectionId"`
+	QualifiedName     string `json:"qualifiedName"`
+	IncludeSubfolders bool   `json:"includeSubfolders"`
+	WithLineage       bool   `json:"withLineage"`
+	TargetFolder      string `json:"targetFolder"`
+
+	// TaskId is the ID of the latest publication task.
+	TaskId string `json:"taskId,omitempty"`
+
+	Owner       string           `json:"ownerId,omitempty"`
+	LastRunTime string           `json:"lastRunTime,omitempty"`
+	LastStatus  string           `json:"lastStatus,omitempty"`
+	Runs        []PublicationRun `json:"runs,omitempty"`
+}
+
+// PublicationRun is a past publication task, as listed with a publication.
+type PublicationRun struct {
+	TaskId    string `json:"taskId"`
+	Status    string `json:"status"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
+
+// CatalogObject is a folder, dataset or file of a connection as listed by the catalog browser.
+type CatalogObject struct {
+	Name          string `json:"name"`
+	QualifiedName string `json:"qualifiedName"`
+	Type          string `json:"type"`
+	HasChildren   bool   `json:"hasChildren"`
+}
+
+type SearchResult struct {
+	Name          string `json:"name"`
+	QualifiedName string `json:"qualifiedName"`
+	ConnectionId  string `json:"connectionId"`
+	Type          string `json:"type"`
+	Description   string `json:"description"`
+}
+
+// searchPage maps a single page of search results.
+type searchPage struct {
+	Total   int            `json:"total"`
+	Results []SearchResult `json:"results"`
+}
+
+type Lineage struct {
+	Nodes []LineageNode `json:"nodes"`
+	Edges []LineageEdge `json:"edges"`
+}
+
+// LineageNode is a dataset, operator or graph of a lineage graph.
+type LineageNode struct {
+	Id            string `json:"id"`
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	ConnectionId  string `json:"connectionId"`
+	QualifiedName string `json:"qualifiedName"`
+}
+
+// LineageEdge connects the node Source to the node Target it flows into.
+type LineageEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+type Glossary struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type GlossaryTerm struct {
+	Id               string            `json:"id,omitempty"`
+	GlossaryId       string            `json:"glossaryId"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description"`
+	Synonyms         []string          `json:"synonyms"`
+	CustomAttributes map[string]string `json:"customAttributes"`
+	RelatedTerms     []string          `json:"relatedTerms"`
+}
+
+// GlossaryTermAssignment links a glossary term to a dataset or one of its columns.
+type GlossaryTermAssignment struct {
+	Id            string `json:"id,omitempty"`
+	TermId        string `json:"termId"`
+	ConnectionId  string `json:"connectionId"`
+	QualifiedName string `json:"qualifiedName"`
+	ColumnName    string `json:"columnName,omitempty"`
+}
+
+type TagHierarchy struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	// Tags is only returned when listing tag hierarchies.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+type Tag struct {
+	Id          string `json:"id,omitempty"`
+	HierarchyId string `json:"hierarchyId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	// ParentId is empty for tags at the top of the hierarchy. It is always
+	// sent, so that updates move a tag to the top instead of keeping its parent.
+	ParentId string `json:"parentId"`
+}
+
+// DatasetTags are the tag IDs assigned to a dataset and, by column name, to its columns.
+type DatasetTags struct {
+	Tags    []string            `json:"tags"`
+	Columns map[string][]string `json:"columns"`
+}
+
+type RuleCategory struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type Rule struct {
+	Id          string          `json:"id,omitempty"`
+	CategoryId  string          `json:"categoryId"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Parameters  []RuleParameter `json:"parameters"`
+
+	// Filters select the rows checked by the rule, all rows are checked if empty.
+	Filters    []RuleCondition `json:"filters"`
+	Conditions []RuleCondition `json:"conditions"`
+}
+
+// RuleParameter is a placeholder of a rule, bound to a column by rulebooks.
+type RuleParameter struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// RuleCondition is a named expression over the parameters of a rule, e.g. $email MATCH '%@%'.
+type RuleCondition struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+type Rulebook struct {
+	Id          string        `json:"id,omitempty"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Bindings    []RuleBinding `json:"bindings"`
+}
+
+// RuleBinding applies a rule to a dataset, mapping rule parameters to column names.
+type RuleBinding struct {
+	RuleId        string            `json:"ruleId"`
+	ConnectionId  string            `json:"connectionId"`
+	QualifiedName string            `json:"qualifiedName"`
+	ColumnMapping map[string]string `json:"columnMapping"`
+}
+
+// RulebookResults are the results of the latest evaluation of a rulebook.
+type RulebookResults struct {
+	RulebookId string       `json:"rulebookId"`
+	TaskId     string       `json:"taskId"`
+	Timestamp  string       `json:"timestamp"`
+	Rules      []RuleResult `json:"rules"`
+}
+
+// RuleResult counts the rows which passed and failed a rule bound to a dataset.
+type RuleResult struct {
+	RuleId        string `json:"ruleId"`
+	RuleName      string `json:"ruleName"`
+	ConnectionId  string `json:"connectionId"`
+	QualifiedName string `json:"qualifiedName"`
+	PassedRows    int64  `json:"passedRows"`
+	FailedRows    int64  `json:"failedRows"`
+}
+
+// DataPreview holds sample rows of a dataset, with the values of each row in
+// the order of Columns.
+type DataPreview struct {
+	Columns []DataPreviewColumn `json:"columns"`
+	Rows    [][]any             `json:"rows"`
+}
+
+type DataPreviewColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type GraphExecution struct {
+	Handle string `json:"handle"`
+	Src    string `json:"src"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+
+	// Message explains why a dead graph failed.
+	Message string `json:"message"`
+}
+
+// graphStart maps the request body starting a graph.
+type graphStart struct {
+	Src                        string            `json:"src"`
+	Name                       string            `json:"name"`
+	ConfigurationSubstitutions map[string]string `json:"configurationSubstitutions"`
+}
@@ -0,0 +1,65 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MaxPreviewRows caps the rows returned by GetDataPreview, so previews
+// cannot blow up the Terraform state.
+const MaxPreviewRows = 1000
+
+// GetDataPreview - Returns up to rows sample rows of a dataset, restricted to
+// columns if any are given. Rows outside 1 to MaxPreviewRows are capped at MaxPreviewRows.
+func (c *Client) GetDataPreview(ctx context.Context, connection string, dataset string, columns []string, rows int) (*DataPreview, error) {
+	if rows < 1 || rows > MaxPreviewRows {
+		rows = MaxPreviewRows
+	}
+
+	// replace forward slashes with %2F
+	dataset = strings.Replace(dataset, "/", "%2F", -1)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf(
+			"%s/app/datahub-app-metadata/api/v1/catalog/connections/%s/datasets/%s/preview",
+			c.HostURL,
+			connection,
+			dataset,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	query.Set("top", strconv.Itoa(rows))
+	if len(columns) > 0 {
+		query.Set("columns", strings.Join(columns, ","))
+	}
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &DataPreview{}
+	err = json.Unmarshal(body, preview)
+	if err != nil {
+		return nil, err
+	}
+
+	// Do not rely on the server to honor top.
+	if len(preview.Rows) > rows {
+		preview.Rows = preview.Rows[:rows]
+	}
+
+	return preview, nil
+}
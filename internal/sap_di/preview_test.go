@@ -0,0 +1,66 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestGetDataPreviewRowCap(t *testing.T) {
+	var tops []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		tops = append(tops, query.Get("top"))
+
+		if query.Get("columns") != "MANDT,ANZST" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// Ignore top and return more rows than allowed.
+		preview := DataPreview{
+			Columns: []DataPreviewColumn{{Name: "MANDT", Type: "STRING"}, {Name: "ANZST", Type: "INTEGER"}},
+			Rows:    [][]any{},
+		}
+		for i := 0; i < MaxPreviewRows+10; i++ {
+			preview.Rows = append(preview.Rows, []any{strconv.Itoa(i), i})
+		}
+		json.NewEncoder(w).Encode(preview)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	columns := []string{"MANDT", "ANZST"}
+
+	preview, err := client.GetDataPreview(context.Background(), "P40_XYZ", "/XYZ/012/ABCD", columns, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(preview.Rows) != 5 {
+		t.Errorf("expected 5 rows, got %d", len(preview.Rows))
+	}
+
+	// Larger and unset row counts are capped.
+	for _, rows := range []int{MaxPreviewRows * 10, 0} {
+		preview, err = client.GetDataPreview(context.Background(), "P40_XYZ", "/XYZ/012/ABCD", columns, rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(preview.Rows) != MaxPreviewRows {
+			t.Errorf("expected %d rows for %d requested, got %d", MaxPreviewRows, rows, len(preview.Rows))
+		}
+	}
+
+	max := strconv.Itoa(MaxPreviewRows)
+	if len(tops) != 3 || tops[0] != "5" || tops[1] != max || tops[2] != max {
+		t.Errorf("expected top 5, %s and %s, got %v", max, max, tops)
+	}
+}
@@ -0,0 +1,43 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// StartProfiling - Starts profiling a dataset and returns the profiling task.
+func (c *Client) StartProfiling(ctx context.Context, connection string, dataset string) (*Task, error) {
+	// replace forward slashes with %2F
+	dataset = strings.Replace(dataset, "/", "%2F", -1)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf(
+			"%s/app/datahub-app-metadata/api/v1/catalog/connections/%s/datasets/%s/profile",
+			c.HostURL,
+			connection,
+			dataset,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	started := &startedTask{}
+	err = json.Unmarshal(body, started)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTask(ctx, started.TaskId)
+}
@@ -0,0 +1,159 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ListPublications - Returns all publications with their latest runs.
+func (c *Client) ListPublications(ctx context.Context) ([]Publication, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/catalog/publications", c.HostURL),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	publications := []Publication{}
+	err = json.Unmarshal(body, &publications)
+	if err != nil {
+		return nil, err
+	}
+
+	return publications, nil
+}
+
+// GetPublication - Returns a specific publication.
+func (c *Client) GetPublication(ctx context.Context, id string) (*Publication, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/catalog/publications/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	publication := &Publication{}
+	err = json.Unmarshal(body, publication)
+	if err != nil {
+		return nil, err
+	}
+
+	return publication, nil
+}
+
+// CreatePublication - Creates a new publication and starts publishing it.
+// The returned publication carries the ID of the started task.
+func (c *Client) CreatePublication(ctx context.Context, publication Publication) (*Publication, error) {
+	rb, err := json.Marshal(publication)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/catalog/publications", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	started := &Publication{}
+	err = json.Unmarshal(body, started)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.readStartedPublication(ctx, started.Id, started.TaskId)
+}
+
+// UpdatePublication - Updates an existing publication and publishes it again.
+// The returned publication carries the ID of the started task.
+func (c *Client) UpdatePublication(ctx context.Context, publication Publication) (*Publication, error) {
+	rb, err := json.Marshal(publication)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every PUT starts another publication task, so it must not be retried.
+	req, err := http.NewRequestWithContext(
+		withoutRetry(ctx),
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/catalog/publications/%s", c.HostURL, publication.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	started := &startedTask{}
+	err = json.Unmarshal(body, started)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.readStartedPublication(ctx, publication.Id, started.TaskId)
+}
+
+// DeletePublication - Deletes a publication and removes its content from the catalog.
+func (c *Client) DeletePublication(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/catalog/publications/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// readStartedPublication reads back a publication after a publication task was started.
+func (c *Client) readStartedPublication(ctx context.Context, id string, taskId string) (*Publication, error) {
+	if taskId == "" {
+		return nil, fmt.Errorf("publishing %s did not start a task", id)
+	}
+
+	publication, err := c.GetPublication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	publication.TaskId = taskId
+
+	return publication, nil
+}
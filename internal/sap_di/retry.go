@@ -0,0 +1,120 @@
+package sap_di
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryStruct configures how failed requests are retried.
+type RetryStruct struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, doubled for every further retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential backoff.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetry is used by clients unless configured otherwise.
+var DefaultRetry = RetryStruct{
+	MaxRetries: 3,
+	MinBackoff: 1 * time.Second,
+	MaxBackoff: 30 * time.Second,
+}
+
+// noRetryKey marks contexts whose requests are never retried.
+type noRetryKey struct{}
+
+// withoutRetry returns a context whose requests are sent only once. It is
+// meant for requests with an idempotent method which still start a task in
+// DI, so a retry after a lost response would start the task twice.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// retryable reports whether a failed attempt may be retried. Only idempotent
+// requests are retried, either on transport errors or on statuses that
+// signal a temporary outage of DI or its ingress.
+func retryable(ctx context.Context, req *http.Request, res *http.Response, err error) bool {
+	if ctx.Err() != nil || ctx.Value(noRetryKey{}) != nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait before the given retry. A Retry-After header sent
+// by DI takes precedence over the exponential backoff, both are capped by
+// MaxBackoff.
+func (r RetryStruct) backoff(attempt int, res *http.Response) time.Duration {
+	if wait, ok := retryAfter(res); ok {
+		if wait > r.MaxBackoff {
+			wait = r.MaxBackoff
+		}
+		return wait
+	}
+
+	wait := r.MinBackoff
+	for i := 1; i < attempt && wait < r.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > r.MaxBackoff {
+		wait = r.MaxBackoff
+	}
+
+	return wait
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// statusOf returns the status code of res, or 0 if there is no response.
+func statusOf(res *http.Response) int {
+	if res == nil {
+		return 0
+	}
+
+	return res.StatusCode
+}
@@ -0,0 +1,118 @@
+package sap_di
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDoRequestRetry(t *testing.T) {
+	requests := 0
+	failures := 2
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(csrfTokenHeader) == "Fetch" {
+			return
+		}
+
+		requests++
+		if requests <= failures {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Retry = RetryStruct{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	body, err := client.doRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != "ok" || requests != 3 {
+		t.Errorf("expected ok after 3 requests, got %q after %d", body, requests)
+	}
+
+	// Non-idempotent requests are not retried.
+	requests = 0
+	req, _ = http.NewRequest("POST", server.URL, strings.NewReader("{}"))
+	if _, err := client.doRequest(req); err == nil {
+		t.Error("expected POST to fail without retry")
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	// Requests opted out of retries are sent once.
+	requests = 0
+	req, _ = http.NewRequestWithContext(withoutRetry(context.Background()), "PUT", server.URL, strings.NewReader("{}"))
+	if _, err := client.doRequest(req); err == nil {
+		t.Error("expected PUT without retry to fail")
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	// Retries are limited.
+	requests = 0
+	failures = 10
+	client.Retry.MaxRetries = 2
+	req, _ = http.NewRequest("GET", server.URL, nil)
+	if _, err := client.doRequest(req); err == nil {
+		t.Error("expected GET to fail after retries")
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	retry := RetryStruct{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	for attempt, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second} {
+		if got := retry.backoff(attempt, nil); got != expected {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, expected, got)
+		}
+	}
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got := retry.backoff(1, res); got != 3*time.Second {
+		t.Errorf("expected Retry-After of 3s, got %s", got)
+	}
+
+	res = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	if got := retry.backoff(1, res); got != 5*time.Second {
+		t.Errorf("expected Retry-After capped at 5s, got %s", got)
+	}
+}
+
+func TestDoRequestRetryCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Retry = RetryStruct{MaxRetries: 3, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+	if _, err := client.doRequest(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to cancel the retry wait, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RunRulebook - Starts evaluating the rules of a rulebook and returns the evaluation task.
+func (c *Client) RunRulebook(ctx context.Context, rulebookId string) (*Task, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rulebooks/%s/runs", c.HostURL, rulebookId),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	started := &startedTask{}
+	err = json.Unmarshal(body, started)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTask(ctx, started.TaskId)
+}
+
+// GetRulebookResults - Returns the results of the latest evaluation of a rulebook.
+func (c *Client) GetRulebookResults(ctx context.Context, rulebookId string) (*RulebookResults, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rulebooks/%s/results", c.HostURL, rulebookId),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &RulebookResults{}
+	err = json.Unmarshal(body, results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
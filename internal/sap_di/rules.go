@@ -0,0 +1,309 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetRuleCategory - Returns a specific rule category.
+func (c *Client) GetRuleCategory(ctx context.Context, id string) (*RuleCategory, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/ruleCategories/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &RuleCategory{}
+	err = json.Unmarshal(body, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// CreateRuleCategory - Creates a new rule category.
+func (c *Client) CreateRuleCategory(ctx context.Context, category RuleCategory) (*RuleCategory, error) {
+	rb, err := json.Marshal(category)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/ruleCategories", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &RuleCategory{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetRuleCategory(ctx, created.Id)
+}
+
+// UpdateRuleCategory - Updates an existing rule category.
+func (c *Client) UpdateRuleCategory(ctx context.Context, category RuleCategory) (*RuleCategory, error) {
+	rb, err := json.Marshal(category)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/ruleCategories/%s", c.HostURL, category.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetRuleCategory(ctx, category.Id)
+}
+
+// DeleteRuleCategory - Deletes a rule category.
+func (c *Client) DeleteRuleCategory(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/ruleCategories/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// GetRule - Returns a specific rule.
+func (c *Client) GetRule(ctx context.Context, id string) (*Rule, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rules/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &Rule{}
+	err = json.Unmarshal(body, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// CreateRule - Creates a new rule.
+func (c *Client) CreateRule(ctx context.Context, rule Rule) (*Rule, error) {
+	rb, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rules", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &Rule{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetRule(ctx, created.Id)
+}
+
+// UpdateRule - Updates an existing rule.
+func (c *Client) UpdateRule(ctx context.Context, rule Rule) (*Rule, error) {
+	rb, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rules/%s", c.HostURL, rule.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetRule(ctx, rule.Id)
+}
+
+// DeleteRule - Deletes a rule.
+func (c *Client) DeleteRule(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rules/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// GetRulebook - Returns a specific rulebook.
+func (c *Client) GetRulebook(ctx context.Context, id string) (*Rulebook, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rulebooks/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rulebook := &Rulebook{}
+	err = json.Unmarshal(body, rulebook)
+	if err != nil {
+		return nil, err
+	}
+
+	return rulebook, nil
+}
+
+// CreateRulebook - Creates a new rulebook.
+func (c *Client) CreateRulebook(ctx context.Context, rulebook Rulebook) (*Rulebook, error) {
+	rb, err := json.Marshal(rulebook)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rulebooks", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &Rulebook{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetRulebook(ctx, created.Id)
+}
+
+// UpdateRulebook - Updates an existing rulebook.
+func (c *Client) UpdateRulebook(ctx context.Context, rulebook Rulebook) (*Rulebook, error) {
+	rb, err := json.Marshal(rulebook)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rulebooks/%s", c.HostURL, rulebook.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetRulebook(ctx, rulebook.Id)
+}
+
+// DeleteRulebook - Deletes a rulebook.
+func (c *Client) DeleteRulebook(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/rulebooks/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,95 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// searchPageSize is the number of results requested per page.
+const searchPageSize = 100
+
+// SearchStruct holds the filters of a catalog search.
+type SearchStruct struct {
+	// Query is the full-text search string.
+	Query string
+	// ConnectionId, Tag and Type restrict the results if set.
+	ConnectionId string
+	Tag          string
+	Type         string
+	// MaxResults caps the number of returned results.
+	MaxResults int
+}
+
+// SearchDatasets - Returns the catalog objects matching the search, fetching
+// as many pages as needed for search.MaxResults.
+func (c *Client) SearchDatasets(ctx context.Context, search SearchStruct) ([]SearchResult, error) {
+	results := []SearchResult{}
+
+	for len(results) < search.MaxResults {
+		top := search.MaxResults - len(results)
+		if top > searchPageSize {
+			top = searchPageSize
+		}
+
+		page, err := c.searchPage(ctx, search, len(results), top)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Results) > top {
+			page.Results = page.Results[:top]
+		}
+		results = append(results, page.Results...)
+
+		// Some responses leave out the total, then only a short page ends the search.
+		if len(page.Results) < top || (page.Total > 0 && len(results) >= page.Total) {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// searchPage fetches top results of the search starting at skip.
+func (c *Client) searchPage(ctx context.Context, search SearchStruct, skip int, top int) (*searchPage, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/catalog/search", c.HostURL),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	query.Set("query", search.Query)
+	if search.ConnectionId != "" {
+		query.Set("connectionId", search.ConnectionId)
+	}
+	if search.Tag != "" {
+		query.Set("tag", search.Tag)
+	}
+	if search.Type != "" {
+		query.Set("type", search.Type)
+	}
+	query.Set("skip", strconv.Itoa(skip))
+	query.Set("top", strconv.Itoa(top))
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &searchPage{}
+	err = json.Unmarshal(body, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,83 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestSearchDatasetsPagination(t *testing.T) {
+	total := 250
+	withTotal := true
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		query := r.URL.Query()
+		if query.Get("query") != "material" || query.Get("connectionId") != "P40_XYZ" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		skip, _ := strconv.Atoi(query.Get("skip"))
+		top, _ := strconv.Atoi(query.Get("top"))
+
+		page := searchPage{Total: total, Results: []SearchResult{}}
+		for i := skip; i < skip+top && i < total; i++ {
+			page.Results = append(page.Results, SearchResult{QualifiedName: fmt.Sprintf("/XYZ/%03d", i)})
+		}
+		if !withTotal {
+			json.NewEncoder(w).Encode(map[string]any{"results": page.Results})
+			return
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	search := SearchStruct{Query: "material", ConnectionId: "P40_XYZ", MaxResults: 1000}
+
+	// All pages are fetched until the total is reached.
+	results, err := client.SearchDatasets(context.Background(), search)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != total || requests != 3 {
+		t.Errorf("expected %d results in 3 requests, got %d in %d", total, len(results), requests)
+	}
+	if results[249].QualifiedName != "/XYZ/249" {
+		t.Errorf("expected last result /XYZ/249, got %s", results[249].QualifiedName)
+	}
+
+	// No further pages are fetched once MaxResults is reached.
+	requests = 0
+	search.MaxResults = 150
+	results, err = client.SearchDatasets(context.Background(), search)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 150 || requests != 2 {
+		t.Errorf("expected 150 results in 2 requests, got %d in %d", len(results), requests)
+	}
+
+	// Without a total, pages are fetched until a short page is returned.
+	requests = 0
+	withTotal = false
+	search.MaxResults = 1000
+	results, err = client.SearchDatasets(context.Background(), search)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != total || requests != 3 {
+		t.Errorf("expected %d results in 3 requests without total, got %d in %d", total, len(results), requests)
+	}
+}
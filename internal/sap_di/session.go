@@ -0,0 +1,71 @@
+package sap_di
+
+import (
+	"net/http"
+	"strings"
+)
+
+// csrfTokenHeader is used to fetch and send the CSRF token DI requires for write calls.
+const csrfTokenHeader = "X-CSRF-Token"
+
+// csrfProtected reports whether requests with the given method need a CSRF token.
+func csrfProtected(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// csrfRequired reports whether the response rejected the request because of
+// a missing or expired CSRF token.
+func csrfRequired(req *http.Request, res *http.Response) bool {
+	return csrfProtected(req.Method) &&
+		res.StatusCode == http.StatusForbidden &&
+		strings.EqualFold(res.Header.Get(csrfTokenHeader), "required")
+}
+
+// csrfTokenFor returns the cached CSRF token, fetching a new one from the
+// request's URL if none was fetched yet. An empty token is cached as well, so
+// endpoints without CSRF protection are not asked again for every write.
+func (c *Client) csrfTokenFor(req *http.Request) (string, error) {
+	c.csrfMu.Lock()
+	defer c.csrfMu.Unlock()
+
+	if c.csrfFetched {
+		return c.csrfToken, nil
+	}
+
+	fetch, err := http.NewRequestWithContext(req.Context(), "GET", req.URL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	fetch.Header.Set(csrfTokenHeader, "Fetch")
+
+	err = c.authorize(fetch)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.HTTPClient.Do(fetch)
+	if err != nil {
+		return "", err
+	}
+	res.Body.Close()
+
+	// Endpoints without CSRF protection do not return a token.
+	c.csrfToken = res.Header.Get(csrfTokenHeader)
+	c.csrfFetched = true
+
+	return c.csrfToken, nil
+}
+
+// resetCSRFToken drops the cached CSRF token.
+func (c *Client) resetCSRFToken() {
+	c.csrfMu.Lock()
+	defer c.csrfMu.Unlock()
+
+	c.csrfToken = ""
+	c.csrfFetched = false
+}
@@ -0,0 +1,103 @@
+package sap_di
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDoRequestCSRFToken(t *testing.T) {
+	validToken := "token-1"
+	fetches := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && r.Header.Get(csrfTokenHeader) == "Fetch" {
+			fetches++
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: validToken})
+			w.Header().Set(csrfTokenHeader, validToken)
+			return
+		}
+
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != validToken || r.Header.Get(csrfTokenHeader) != validToken {
+			w.Header().Set(csrfTokenHeader, "Required")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	post := func() string {
+		req, err := http.NewRequest("POST", server.URL+"/api", strings.NewReader("payload"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := client.doRequest(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	if got := post(); got != "payload" {
+		t.Errorf("expected payload, got %q", got)
+	}
+	post()
+	if fetches != 1 {
+		t.Errorf("expected the CSRF token to be fetched once, got %d", fetches)
+	}
+
+	// An expired session is detected and the request is resent with a new token.
+	validToken = "token-2"
+	if got := post(); got != "payload" {
+		t.Errorf("expected resent payload, got %q", got)
+	}
+	if fetches != 2 {
+		t.Errorf("expected the CSRF token to be fetched again, got %d fetches", fetches)
+	}
+}
+
+func TestDoRequestWithoutCSRFToken(t *testing.T) {
+	fetches := 0
+	writes := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && r.Header.Get(csrfTokenHeader) == "Fetch" {
+			fetches++
+			return
+		}
+
+		if r.Header.Get(csrfTokenHeader) != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writes++
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Endpoints without CSRF protection are only asked for a token once.
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("POST", server.URL+"/api", strings.NewReader("payload"))
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fetches != 1 || writes != 3 {
+		t.Errorf("expected 1 fetch and 3 writes, got %d fetches and %d writes", fetches, writes)
+	}
+}
@@ -0,0 +1,235 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ListTagHierarchies - Returns all tag hierarchies including their tags.
+func (c *Client) ListTagHierarchies(ctx context.Context) ([]TagHierarchy, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies", c.HostURL),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hierarchies := []TagHierarchy{}
+	err = json.Unmarshal(body, &hierarchies)
+	if err != nil {
+		return nil, err
+	}
+
+	return hierarchies, nil
+}
+
+// GetTagHierarchy - Returns a specific tag hierarchy.
+func (c *Client) GetTagHierarchy(ctx context.Context, id string) (*TagHierarchy, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hierarchy := &TagHierarchy{}
+	err = json.Unmarshal(body, hierarchy)
+	if err != nil {
+		return nil, err
+	}
+
+	return hierarchy, nil
+}
+
+// CreateTagHierarchy - Creates a new tag hierarchy.
+func (c *Client) CreateTagHierarchy(ctx context.Context, hierarchy TagHierarchy) (*TagHierarchy, error) {
+	rb, err := json.Marshal(hierarchy)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies", c.HostURL),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &TagHierarchy{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTagHierarchy(ctx, created.Id)
+}
+
+// UpdateTagHierarchy - Updates an existing tag hierarchy.
+func (c *Client) UpdateTagHierarchy(ctx context.Context, hierarchy TagHierarchy) (*TagHierarchy, error) {
+	rb, err := json.Marshal(hierarchy)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies/%s", c.HostURL, hierarchy.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTagHierarchy(ctx, hierarchy.Id)
+}
+
+// DeleteTagHierarchy - Deletes a tag hierarchy including its tags.
+func (c *Client) DeleteTagHierarchy(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+// GetTag - Returns a specific tag of a tag hierarchy.
+func (c *Client) GetTag(ctx context.Context, hierarchyId string, id string) (*Tag, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies/%s/tags/%s", c.HostURL, hierarchyId, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &Tag{}
+	err = json.Unmarshal(body, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// CreateTag - Creates a new tag in a tag hierarchy.
+func (c *Client) CreateTag(ctx context.Context, tag Tag) (*Tag, error) {
+	rb, err := json.Marshal(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies/%s/tags", c.HostURL, tag.HierarchyId),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &Tag{}
+	err = json.Unmarshal(body, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTag(ctx, tag.HierarchyId, created.Id)
+}
+
+// UpdateTag - Updates an existing tag, which may also move it to another parent.
+func (c *Client) UpdateTag(ctx context.Context, tag Tag) (*Tag, error) {
+	rb, err := json.Marshal(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies/%s/tags/%s", c.HostURL, tag.HierarchyId, tag.Id),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTag(ctx, tag.HierarchyId, tag.Id)
+}
+
+// DeleteTag - Deletes a tag including its child tags.
+func (c *Client) DeleteTag(ctx context.Context, hierarchyId string, id string) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/tagHierarchies/%s/tags/%s", c.HostURL, hierarchyId, id),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,45 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateTagMovesToTop(t *testing.T) {
+	// The server keeps fields which are left out of an update.
+	stored := map[string]any{"id": "TAG_0002", "hierarchyId": "HIER_0001", "name": "Email", "parentId": "TAG_0001"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/app/datahub-app-metadata/api/v1/tagHierarchies/HIER_0001/tags/TAG_0002" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == "PUT" {
+			update := map[string]any{}
+			json.NewDecoder(r.Body).Decode(&update)
+			for key, value := range update {
+				stored[key] = value
+			}
+			return
+		}
+		json.NewEncoder(w).Encode(stored)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tag, err := client.UpdateTag(context.Background(), Tag{Id: "TAG_0002", HierarchyId: "HIER_0001", Name: "Email"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.ParentId != "" {
+		t.Errorf("expected tag at the top of the hierarchy, got parent %s", tag.ParentId)
+	}
+}
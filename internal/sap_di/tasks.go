@@ -0,0 +1,85 @@
+package sap_di
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	TaskStatusPending   = "PENDING"
+	TaskStatusRunning   = "RUNNING"
+	TaskStatusCompleted = "COMPLETED"
+	TaskStatusFailed    = "FAILED"
+	TaskStatusCancelled = "CANCELLED"
+)
+
+// Done reports whether the task reached a final status.
+func (t *Task) Done() bool {
+	switch t.Status {
+	case TaskStatusCompleted, TaskStatusFailed, TaskStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrorMessages returns the error messages reported by the task.
+func (t *Task) ErrorMessages() []string {
+	messages := []string{}
+	for _, message := range t.Messages {
+		if message.Type == "ERROR" {
+			messages = append(messages, message.Message)
+		}
+	}
+
+	return messages
+}
+
+// GetTask - Returns a specific task of the Metadata Explorer monitoring.
+func (c *Client) GetTask(ctx context.Context, id string) (*Task, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/app/datahub-app-metadata/api/v1/monitoring/tasks/%s", c.HostURL, id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	task := &Task{}
+	err = json.Unmarshal(body, task)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// WaitForTask - Polls a task until it reached a final status or ctx is done.
+func (c *Client) WaitForTask(ctx context.Context, id string) (*Task, error) {
+	for {
+		task, err := c.GetTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if task.Done() {
+			return task, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return task, fmt.Errorf("waiting for task %s with status %s: %w", id, task.Status, ctx.Err())
+		case <-time.After(c.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package sap_di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForTask(t *testing.T) {
+	polls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+
+		status := TaskStatusRunning
+		if polls == 3 {
+			status = TaskStatusFailed
+		}
+
+		fmt.Fprintf(w, `{"id":"task-1","status":%q,"messages":[{"type":"INFO","message":"started"},{"type":"ERROR","message":"out of memory"}]}`, status)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.PollInterval = time.Millisecond
+
+	task, err := client.WaitForTask(context.Background(), "task-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if polls != 3 {
+		t.Errorf("expected 3 polls, got %d", polls)
+	}
+	if task.Status != TaskStatusFailed {
+		t.Errorf("expected status %s, got %s", TaskStatusFailed, task.Status)
+	}
+	if messages := task.ErrorMessages(); len(messages) != 1 || messages[0] != "out of memory" {
+		t.Errorf("expected error message out of memory, got %q", messages)
+	}
+}
+
+func TestWaitForTaskTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"task-1","status":"RUNNING"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "admin", Password: "test123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.PollInterval = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.WaitForTask(ctx, "task-1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
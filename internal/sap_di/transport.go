@@ -0,0 +1,96 @@
+package sap_di
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// TransportStruct configures TLS and proxy settings of the HTTP transport.
+// Certificates and keys may be given as PEM or as path to a PEM file.
+type TransportStruct struct {
+	CACertificate      string
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+	// ProxyURL overrides the proxy taken from the HTTPS_PROXY and NO_PROXY
+	// environment variables.
+	ProxyURL string
+}
+
+// NewTransport returns an HTTP transport using the given TLS and proxy settings.
+func NewTransport(config TransportStruct) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Only set when explicitly requested, the provider warns about it.
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertificate != "" {
+		caCert, err := loadPEM(config.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA certificate: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("reading CA certificate: no PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertificate != "" || config.ClientKey != "" {
+		if config.ClientCertificate == "" || config.ClientKey == "" {
+			return nil, fmt.Errorf("client certificate and client key must be set together")
+		}
+
+		clientCert, err := loadPEM(config.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("reading client certificate: %w", err)
+		}
+
+		clientKey, err := loadPEM(config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("reading client key: %w", err)
+		}
+
+		keyPair, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("parsing proxy URL: %q is not an absolute URL", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// loadPEM returns value if it is PEM encoded, otherwise reads the file it points to.
+func loadPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
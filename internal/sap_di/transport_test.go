@@ -0,0 +1,71 @@
+package sap_di
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewTransportCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(caCert), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		config  TransportStruct
+		success bool
+	}{
+		"untrusted":   {config: TransportStruct{}, success: false},
+		"pem":         {config: TransportStruct{CACertificate: caCert}, success: true},
+		"file":        {config: TransportStruct{CACertificate: caFile}, success: true},
+		"skip verify": {config: TransportStruct{InsecureSkipVerify: true}, success: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			transport, err := NewTransport(tc.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if err == nil {
+				res.Body.Close()
+			}
+			if (err == nil) != tc.success {
+				t.Errorf("expected success %t, got error %v", tc.success, err)
+			}
+		})
+	}
+}
+
+func TestNewTransportProxyURL(t *testing.T) {
+	transport, err := NewTransport(TransportStruct{ProxyURL: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest("GET", "https://di.example.com", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy.String() != "http://proxy.example.com:3128" {
+		t.Errorf("expected proxy http://proxy.example.com:3128, got %v (%v)", proxy, err)
+	}
+
+	if _, err := NewTransport(TransportStruct{ProxyURL: "proxy.example.com"}); err == nil {
+		t.Error("expected error for relative proxy URL")
+	}
+}
+
+func TestNewTransportClientCertificateWithoutKey(t *testing.T) {
+	if _, err := NewTransport(TransportStruct{ClientCertificate: "cert.pem"}); err == nil {
+		t.Error("expected error for client certificate without key")
+	}
+}